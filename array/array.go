@@ -1,196 +1,124 @@
 package array
 
 import (
-	"fmt"
-	"math"
+	"iter"
+
+	"github.com/kirillrogovoy/computer-science/arrayGeneric"
 )
 
-type array struct {
-	array []int
-	size  int
-	cap   int
-}
+var (
+	ErrIndexOutOfRange        = arrayGeneric.ErrIndexOutOfRange
+	ErrEmpty                  = arrayGeneric.ErrEmpty
+	ErrConcurrentModification = arrayGeneric.ErrConcurrentModification
+)
 
-func Create(initialCap int) *array {
-	// Covert initial capacity into power of 2. Starting from 16
-	cap := 16
-	for cap < initialCap {
-		cap *= 2
-	}
+type IndexError = arrayGeneric.IndexError
 
-	return &array{make([]int, 0, cap), 0, cap}
-}
+type GrowthPolicy = arrayGeneric.GrowthPolicy
 
-func Cap(arr *array) int {
-	return arr.cap
+func DefaultGrowthPolicy() GrowthPolicy {
+	return arrayGeneric.DefaultGrowthPolicy()
 }
 
-func Size(arr *array) int {
-	return arr.size
-}
+// Array is a thin wrapper around arrayGeneric.Array, see the latter for the implementation
+type Array = arrayGeneric.Array[int]
 
-func IsEmpty(arr *array) bool {
-	return arr.size == 0
+func Create(initialCap int) *Array {
+	return arrayGeneric.Create[int](initialCap)
 }
 
-func At(arr *array, index int) int {
-	size := Size(arr)
-	if index >= size {
-		panic(fmt.Sprintf(
-			"Index out of bound. The size of the array was %d, but the requested index was %d",
-			size,
-			index,
-		))
-	}
-
-	return arr.array[index]
+func CreateWithPolicy(initialCap int, policy GrowthPolicy) *Array {
+	return arrayGeneric.CreateWithPolicy[int](initialCap, policy)
 }
 
-func Set(arr *array, index int, item int) {
-	size := Size(arr)
-	if index >= size {
-		panic(fmt.Sprintf(
-			"Index out of bound. The size of the array was %d, but the requested index was %d",
-			size,
-			index,
-		))
-	}
-
-	arr.array[index] = item
+func Policy(arr *Array) GrowthPolicy {
+	return arrayGeneric.Policy(arr)
 }
 
-func resize(arr *array, newCapacity int) {
-	if newCapacity == Cap(arr) {
-		return
-	}
-
-	size := Size(arr)
-
-	if newCapacity < size {
-		panic(fmt.Sprintf(
-			"Tried to resize an array with size %d to the capacity %d which is smaller",
-			size,
-			newCapacity,
-		))
-	}
-
-	newArray := make([]int, size, newCapacity)
-
-	// copy elements from the old array to the new one
-	for i := 0; i < size; i++ {
-		newArray[i] = At(arr, i)
-	}
-
-	(*arr).array = newArray
-	(*arr).cap = newCapacity
-
-	return
+func Reserve(arr *Array, n int) {
+	arrayGeneric.Reserve(arr, n)
 }
 
-func Push(arr *array, item int) {
-	cap := Cap(arr)
-	size := Size(arr)
-
-	// we are at full capacity
-	if cap == size {
-		resize(arr, cap*2)
-	}
-	arr.array = arr.array[:size+1]
-	arr.size = size + 1
-
-	arr.array[size] = item
+func ShrinkToFit(arr *Array) {
+	arrayGeneric.ShrinkToFit(arr)
 }
 
-func Insert(arr *array, index int, item int) {
-	cap := Cap(arr)
-	size := Size(arr)
-	sizeAbs := int(math.Abs(float64(size)))
-
-	if index > sizeAbs {
-		panic(fmt.Sprintf(
-			"Index out of bound. The size of the array was %d, but the requested index was %d",
-			size,
-			index,
-		))
-	}
+func Cap(arr *Array) int {
+	return arrayGeneric.Cap(arr)
+}
 
-	// allow negative index, means "from the end"
-	if index < 0 {
-		index = size + index
-	}
+func Size(arr *Array) int {
+	return arrayGeneric.Size(arr)
+}
 
-	// we are at full capacity
-	if cap == size {
-		resize(arr, cap*2)
-	}
-	arr.array = arr.array[:size+1]
-	arr.size = size + 1
+func IsEmpty(arr *Array) bool {
+	return arrayGeneric.IsEmpty(arr)
+}
 
-	for i := Size(arr) - 2; i >= index; i-- {
-		arr.array[i+1] = arr.array[i]
-	}
+func At(arr *Array, index int) int {
+	return arrayGeneric.At(arr, index)
+}
 
-	arr.array[index] = item
+func TryAt(arr *Array, index int) (int, error) {
+	return arrayGeneric.TryAt(arr, index)
 }
 
-func Prepend(arr *array, item int) {
-	Insert(arr, 0, item)
+func Set(arr *Array, index int, item int) {
+	arrayGeneric.Set(arr, index, item)
 }
 
-func Pop(arr *array) (result int) {
-	size := Size(arr)
-	index := size - 1 // last element
-	if size <= 0 {
-		panic("Tried to call Pop() on an empty array.")
-	}
+func TrySet(arr *Array, index int, item int) error {
+	return arrayGeneric.TrySet(arr, index, item)
+}
 
-	result = arr.array[index]
+func Push(arr *Array, item int) {
+	arrayGeneric.Push(arr, item)
+}
 
-	Delete(arr, index)
+func Insert(arr *Array, index int, item int) {
+	arrayGeneric.Insert(arr, index, item)
+}
 
-	return
+func TryInsert(arr *Array, index int, item int) error {
+	return arrayGeneric.TryInsert(arr, index, item)
 }
 
-func Delete(arr *array, index int) {
-	size := Size(arr)
-	cap := Cap(arr)
+func Prepend(arr *Array, item int) {
+	arrayGeneric.Prepend(arr, item)
+}
 
-	if index >= size {
-		panic(fmt.Sprintf(
-			"Index out of bound. The size of the array was %d, but the requested index was %d",
-			size,
-			index,
-		))
-	}
+func Pop(arr *Array) int {
+	return arrayGeneric.Pop(arr)
+}
 
-	for i := index; i < size-1; i++ {
-		arr.array[i] = arr.array[i+1]
-	}
+func TryPop(arr *Array) (int, error) {
+	return arrayGeneric.TryPop(arr)
+}
 
-	arr.array = arr.array[:size-1]
-	arr.size = size - 1
+func Delete(arr *Array, index int) {
+	arrayGeneric.Delete(arr, index)
+}
 
-	if Size(arr)*4 <= cap && cap/2 >= 16 {
-		resize(arr, cap/2)
-	}
+func TryDelete(arr *Array, index int) error {
+	return arrayGeneric.TryDelete(arr, index)
 }
 
-func Find(arr *array, item int) (int, bool) {
-	for i := 0; i < Size(arr); i++ {
-		if At(arr, i) == item {
-			return i, true
-		}
-	}
+func Find(arr *Array, item int) (int, bool) {
+	return arrayGeneric.Find(arr, item)
+}
 
-	return 0, false
+func Remove(arr *Array, item int) bool {
+	return arrayGeneric.Remove(arr, item)
 }
 
-func Remove(arr *array, item int) bool {
-	index, ok := Find(arr, item)
+func All(arr *Array) iter.Seq2[int, int] {
+	return arrayGeneric.All(arr)
+}
 
-	if ok {
-		Delete(arr, index)
-	}
+func Values(arr *Array) iter.Seq[int] {
+	return arrayGeneric.Values(arr)
+}
 
-	return ok
+func Backward(arr *Array) iter.Seq2[int, int] {
+	return arrayGeneric.Backward(arr)
 }
@@ -1,6 +1,7 @@
 package array
 
 import (
+	"errors"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -30,16 +31,15 @@ func TestCreate(t *testing.T) {
 
 func TestCap(t *testing.T) {
 	arr := Create(16)
-	expected := arr.cap
-	actual := Cap(arr)
-	require.Equal(t, expected, actual, "Cap(arr) should return the actual capacity of the array")
+	require.Equal(t, 16, Cap(arr), "Cap(arr) should return the actual capacity of the array")
 }
 
 func TestSize(t *testing.T) {
 	arr := Create(16)
-	expected := arr.size
-	actual := Size(arr)
-	require.Equal(t, expected, actual, "Size(arr) should return the actual size of the array")
+	require.Equal(t, 0, Size(arr), "Size(arr) should return the actual size of the array")
+
+	Push(arr, 1)
+	require.Equal(t, 1, Size(arr), "Size(arr) should return the actual size of the array")
 }
 
 func TestIsEmpty(t *testing.T) {
@@ -48,8 +48,7 @@ func TestIsEmpty(t *testing.T) {
 
 	require.Equal(t, true, IsEmpty(arr), "IsEmpty should return true when the size of the array is 0")
 
-	// HACK: it's actually impossible to change the size of the array this way, only by appending
-	arr.size = 10
+	Push(arr, 1)
 
 	require.Equal(t, false, IsEmpty(arr), "IsEmpty should return false when the size of the array is greater than 0")
 }
@@ -69,8 +68,7 @@ func TestAt(t *testing.T) {
 	arr := Create(16)
 
 	expected := 4
-	arr.array = append(arr.array, expected)
-	arr.size = 1
+	Push(arr, expected)
 	actual := At(arr, 0)
 
 	require.Equal(t, expected, actual)
@@ -97,39 +95,6 @@ func TestSet(t *testing.T) {
 	require.Equal(t, 5, At(arr, 0))
 }
 
-func TestResizePanic(t *testing.T) {
-	defer func() {
-		if r := recover(); r == nil {
-			t.Error("Resize() should panic when the new capacity is smaller than the size, but it didn't")
-		}
-	}()
-
-	oldCapacity := 16
-	newCapacity := 15
-
-	arr := Create(oldCapacity)
-
-	// emulate filling all available space
-	arr.size = oldCapacity
-
-	resize(arr, newCapacity)
-}
-
-func TestResize(t *testing.T) {
-	oldCapacity := 16
-	newCapacity := 33
-
-	arr := Create(oldCapacity)
-
-	require.Equal(t, oldCapacity, cap(arr.array))
-	require.Equal(t, oldCapacity, Cap(arr))
-
-	resize(arr, newCapacity)
-
-	require.Equal(t, newCapacity, cap(arr.array))
-	require.Equal(t, newCapacity, Cap(arr))
-}
-
 func TestPush(t *testing.T) {
 	arr := Create(16)
 
@@ -170,17 +135,17 @@ func TestInsert(t *testing.T) {
 	require.Equal(t, 16, Cap(arr))
 	require.Equal(t, 8, Size(arr))
 
-	require.Equal(t, []int{1, 1, 1, 1, 1, 1, 1, 1}, arr.array)
+	require.Equal(t, []int{1, 1, 1, 1, 1, 1, 1, 1}, toSlice(arr))
 
 	// Insert 2 just in the middle of the array, expecting the elements to shift
 	Insert(arr, 3, 2)
 	require.Equal(t, 9, Size(arr))
-	require.Equal(t, []int{1, 1, 1, 2, 1, 1, 1, 1, 1}, arr.array)
+	require.Equal(t, []int{1, 1, 1, 2, 1, 1, 1, 1, 1}, toSlice(arr))
 
 	// Test negative index
 	Insert(arr, -3, 2)
 	require.Equal(t, 10, Size(arr))
-	require.Equal(t, []int{1, 1, 1, 2, 1, 1, 2, 1, 1, 1}, arr.array)
+	require.Equal(t, []int{1, 1, 1, 2, 1, 1, 2, 1, 1, 1}, toSlice(arr))
 
 	// Make the size of the array > 16
 	for i := 10; i < 18; i++ {
@@ -198,11 +163,11 @@ func TestPrepend(t *testing.T) {
 
 	Prepend(arr, 1)
 	require.Equal(t, 1, Size(arr))
-	require.Equal(t, []int{1}, arr.array)
+	require.Equal(t, []int{1}, toSlice(arr))
 
 	Prepend(arr, 2)
 	require.Equal(t, 2, Size(arr))
-	require.Equal(t, []int{2, 1}, arr.array)
+	require.Equal(t, []int{2, 1}, toSlice(arr))
 }
 
 func TestPopPanic(t *testing.T) {
@@ -249,15 +214,15 @@ func TestDelete(t *testing.T) {
 	require.Equal(t, 3, Size(arr))
 
 	Delete(arr, 1)
-	require.Equal(t, []int{1, 3}, arr.array)
+	require.Equal(t, []int{1, 3}, toSlice(arr))
 	require.Equal(t, 2, Size(arr))
 
 	Delete(arr, 0)
-	require.Equal(t, []int{3}, arr.array)
+	require.Equal(t, []int{3}, toSlice(arr))
 	require.Equal(t, 1, Size(arr))
 
 	Delete(arr, 0)
-	require.Equal(t, []int{}, arr.array)
+	require.Equal(t, []int{}, toSlice(arr))
 	require.Equal(t, 0, Size(arr))
 }
 
@@ -315,11 +280,144 @@ func TestRemove(t *testing.T) {
 
 	ok = Remove(arr, 4)
 	require.Equal(t, false, ok)
-	require.Equal(t, []int{1, 2, 3}, arr.array)
+	require.Equal(t, []int{1, 2, 3}, toSlice(arr))
 	require.Equal(t, 3, Size(arr))
 
 	ok = Remove(arr, 2)
 	require.Equal(t, true, ok)
-	require.Equal(t, []int{1, 3}, arr.array)
+	require.Equal(t, []int{1, 3}, toSlice(arr))
 	require.Equal(t, 2, Size(arr))
 }
+
+func toSlice(arr *Array) []int {
+	result := make([]int, 0)
+	for i := 0; i < Size(arr); i++ {
+		result = append(result, At(arr, i))
+	}
+	return result
+}
+
+func TestMethods(t *testing.T) {
+	var arr *Array = Create(16)
+	arr.Push(1)
+	arr.Prepend(2)
+
+	require.Equal(t, []int{2, 1}, toSlice(arr))
+	require.Equal(t, 1, arr.Pop())
+}
+
+func TestErrors(t *testing.T) {
+	arr := Create(16)
+
+	_, err := TryAt(arr, 0)
+	require.Equal(t, true, errors.Is(err, ErrIndexOutOfRange))
+	require.Equal(t, &IndexError{Index: 0, Size: 0}, err)
+
+	require.Equal(t, &IndexError{Index: 0, Size: 0}, TrySet(arr, 0, 1))
+	require.Equal(t, &IndexError{Index: 1, Size: 0}, TryInsert(arr, 1, 1))
+	require.Equal(t, &IndexError{Index: 0, Size: 0}, TryDelete(arr, 0))
+
+	_, err = TryPop(arr)
+	require.Equal(t, ErrEmpty, err)
+
+	require.Nil(t, TryInsert(arr, 0, 1))
+	require.Nil(t, TrySet(arr, 0, 2))
+	require.Nil(t, TryDelete(arr, 0))
+}
+
+func TestNegativeIndex(t *testing.T) {
+	arr := Create(16)
+	Push(arr, 1)
+	Push(arr, 2)
+	Push(arr, 3)
+
+	// -1 is the last element, -size is the first one
+	require.Equal(t, 3, At(arr, -1))
+	require.Equal(t, 1, At(arr, -3))
+
+	_, err := TryAt(arr, -4)
+	require.Equal(t, &IndexError{Index: -4, Size: 3}, err)
+
+	Set(arr, -2, 5)
+	require.Equal(t, []int{1, 5, 3}, toSlice(arr))
+
+	Delete(arr, -1)
+	require.Equal(t, []int{1, 5}, toSlice(arr))
+
+	require.Equal(t, &IndexError{Index: -3, Size: 2}, TryDelete(arr, -3))
+	require.Equal(t, &IndexError{Index: -3, Size: 2}, TrySet(arr, -3, 1))
+	require.Equal(t, &IndexError{Index: -3, Size: 2}, TryInsert(arr, -3, 1))
+}
+
+func TestGrowthPolicy(t *testing.T) {
+	arr := CreateWithPolicy(3, GrowthPolicy{GrowthFactor: 1.5, Exact: true})
+	require.Equal(t, 3, Cap(arr))
+
+	for i := 0; i < 4; i++ {
+		Push(arr, i)
+	}
+	require.Equal(t, 5, Cap(arr))
+
+	Reserve(arr, 10)
+	require.Equal(t, 10, Cap(arr))
+
+	ShrinkToFit(arr)
+	require.Equal(t, 4, Cap(arr))
+	require.Equal(t, []int{0, 1, 2, 3}, toSlice(arr))
+}
+
+func TestIterators(t *testing.T) {
+	arr := Create(16)
+	Push(arr, 1)
+	Push(arr, 2)
+	Push(arr, 3)
+
+	items := []int{}
+	for item := range Values(arr) {
+		items = append(items, item)
+	}
+	require.Equal(t, []int{1, 2, 3}, items)
+
+	indices := []int{}
+	for i := range All(arr) {
+		indices = append(indices, i)
+	}
+	require.Equal(t, []int{0, 1, 2}, indices)
+
+	for i, item := range arr.Backward() {
+		require.Equal(t, i+1, item)
+	}
+}
+
+func TestSort(t *testing.T) {
+	for _, algorithm := range Algorithms {
+		arr := Create(16)
+		for _, item := range []int{4, 2, 5, 1, 3} {
+			Push(arr, item)
+		}
+
+		require.Equal(t, false, IsSorted(arr))
+		SortWith(arr, algorithm)
+		require.Equal(t, []int{1, 2, 3, 4, 5}, toSlice(arr))
+		require.Equal(t, true, IsSorted(arr))
+	}
+}
+
+func TestSortedOperations(t *testing.T) {
+	arr := Create(16)
+	for _, item := range []int{3, 1, 2} {
+		InsertSorted(arr, item)
+	}
+	require.Equal(t, []int{1, 2, 3}, toSlice(arr))
+
+	for _, search := range []func(*Array, int) (int, bool){BinarySearch, ExponentialSearch, InterpolationSearch} {
+		index, found := search(arr, 2)
+		require.Equal(t, true, found)
+		require.Equal(t, 1, index)
+	}
+
+	require.Equal(t, 1, LowerBound(arr, 2))
+	require.Equal(t, 2, UpperBound(arr, 2))
+	require.Equal(t, true, RemoveSorted(arr, 2))
+	require.Equal(t, []int{1, 3}, toSlice(arr))
+}
@@ -0,0 +1,33 @@
+package array
+
+import (
+	"github.com/kirillrogovoy/computer-science/arrayGeneric"
+)
+
+func BinarySearch(arr *Array, item int) (int, bool) {
+	return arrayGeneric.BinarySearch(arr, item)
+}
+
+func LowerBound(arr *Array, item int) int {
+	return arrayGeneric.LowerBound(arr, item)
+}
+
+func UpperBound(arr *Array, item int) int {
+	return arrayGeneric.UpperBound(arr, item)
+}
+
+func InsertSorted(arr *Array, item int) int {
+	return arrayGeneric.InsertSorted(arr, item)
+}
+
+func RemoveSorted(arr *Array, item int) bool {
+	return arrayGeneric.RemoveSorted(arr, item)
+}
+
+func ExponentialSearch(arr *Array, item int) (int, bool) {
+	return arrayGeneric.ExponentialSearch(arr, item)
+}
+
+func InterpolationSearch(arr *Array, item int) (int, bool) {
+	return arrayGeneric.InterpolationSearch(arr, item)
+}
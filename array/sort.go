@@ -0,0 +1,53 @@
+package array
+
+import (
+	"github.com/kirillrogovoy/computer-science/arrayGeneric"
+)
+
+type Algorithm = arrayGeneric.Algorithm
+
+const (
+	Intro     = arrayGeneric.Intro
+	Insertion = arrayGeneric.Insertion
+	Merge     = arrayGeneric.Merge
+	Quick     = arrayGeneric.Quick
+	Heap      = arrayGeneric.Heap
+)
+
+var Algorithms = arrayGeneric.Algorithms
+
+func Sort(arr *Array) {
+	arrayGeneric.Sort(arr)
+}
+
+func SortWith(arr *Array, algorithm Algorithm) {
+	arrayGeneric.SortWith(arr, algorithm)
+}
+
+func SortStable(arr *Array) {
+	arrayGeneric.SortStable(arr)
+}
+
+func InsertionSort(arr *Array) {
+	arrayGeneric.InsertionSort(arr)
+}
+
+func MergeSort(arr *Array) {
+	arrayGeneric.MergeSort(arr)
+}
+
+func QuickSort(arr *Array) {
+	arrayGeneric.QuickSort(arr)
+}
+
+func HeapSort(arr *Array) {
+	arrayGeneric.HeapSort(arr)
+}
+
+func IntroSort(arr *Array) {
+	arrayGeneric.IntroSort(arr)
+}
+
+func IsSorted(arr *Array) bool {
+	return arrayGeneric.IsSorted(arr)
+}
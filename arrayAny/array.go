@@ -1,198 +1,124 @@
 package arrayInt
 
 import (
-	"fmt"
-	"math"
+	"iter"
+
+	"github.com/kirillrogovoy/computer-science/arrayGeneric"
 )
 
-type any interface{}
+var (
+	ErrIndexOutOfRange        = arrayGeneric.ErrIndexOutOfRange
+	ErrEmpty                  = arrayGeneric.ErrEmpty
+	ErrConcurrentModification = arrayGeneric.ErrConcurrentModification
+)
 
-type array struct {
-	array []any
-	size  int
-	cap   int
-}
+type IndexError = arrayGeneric.IndexError
 
-func Create(initialCap int) *array {
-	// Covert initial capacity into power of 2. Starting from 16
-	cap := 16
-	for cap < initialCap {
-		cap *= 2
-	}
+type GrowthPolicy = arrayGeneric.GrowthPolicy
 
-	return &array{make([]any, 0, cap), 0, cap}
+func DefaultGrowthPolicy() GrowthPolicy {
+	return arrayGeneric.DefaultGrowthPolicy()
 }
 
-func Cap(arr *array) int {
-	return arr.cap
-}
+// Array is a thin wrapper around arrayGeneric.Array, see the latter for the implementation
+type Array = arrayGeneric.Array[any]
 
-func Size(arr *array) int {
-	return arr.size
+func Create(initialCap int) *Array {
+	return arrayGeneric.Create[any](initialCap)
 }
 
-func IsEmpty(arr *array) bool {
-	return arr.size == 0
+func CreateWithPolicy(initialCap int, policy GrowthPolicy) *Array {
+	return arrayGeneric.CreateWithPolicy[any](initialCap, policy)
 }
 
-func At(arr *array, index int) any {
-	size := Size(arr)
-	if index >= size {
-		panic(fmt.Sprintf(
-			"Index out of bound. The size of the array was %d, but the requested index was %d",
-			size,
-			index,
-		))
-	}
-
-	return arr.array[index]
+func Policy(arr *Array) GrowthPolicy {
+	return arrayGeneric.Policy(arr)
 }
 
-func Set(arr *array, index int, item any) {
-	size := Size(arr)
-	if index >= size {
-		panic(fmt.Sprintf(
-			"Index out of bound. The size of the array was %d, but the requested index was %d",
-			size,
-			index,
-		))
-	}
-
-	arr.array[index] = item
+func Reserve(arr *Array, n int) {
+	arrayGeneric.Reserve(arr, n)
 }
 
-func resize(arr *array, newCapacity int) {
-	if newCapacity == Cap(arr) {
-		return
-	}
-
-	size := Size(arr)
-
-	if newCapacity < size {
-		panic(fmt.Sprintf(
-			"Tried to resize an array with size %d to the capacity %d which is smaller",
-			size,
-			newCapacity,
-		))
-	}
-
-	newArray := make([]any, size, newCapacity)
-
-	// copy elements from the old array to the new one
-	for i := 0; i < size; i++ {
-		newArray[i] = At(arr, i)
-	}
-
-	(*arr).array = newArray
-	(*arr).cap = newCapacity
-
-	return
+func ShrinkToFit(arr *Array) {
+	arrayGeneric.ShrinkToFit(arr)
 }
 
-func Push(arr *array, item any) {
-	cap := Cap(arr)
-	size := Size(arr)
-
-	// we are at full capacity
-	if cap == size {
-		resize(arr, cap*2)
-	}
-	arr.array = arr.array[:size+1]
-	arr.size = size + 1
-
-	arr.array[size] = item
+func Cap(arr *Array) int {
+	return arrayGeneric.Cap(arr)
 }
 
-func Insert(arr *array, index int, item any) {
-	cap := Cap(arr)
-	size := Size(arr)
-	sizeAbs := int(math.Abs(float64(size)))
-
-	if index > sizeAbs {
-		panic(fmt.Sprintf(
-			"Index out of bound. The size of the array was %d, but the requested index was %d",
-			size,
-			index,
-		))
-	}
-
-	// allow negative index, means "from the end"
-	if index < 0 {
-		index = size + index
-	}
+func Size(arr *Array) int {
+	return arrayGeneric.Size(arr)
+}
 
-	// we are at full capacity
-	if cap == size {
-		resize(arr, cap*2)
-	}
-	arr.array = arr.array[:size+1]
-	arr.size = size + 1
+func IsEmpty(arr *Array) bool {
+	return arrayGeneric.IsEmpty(arr)
+}
 
-	for i := Size(arr) - 2; i >= index; i-- {
-		arr.array[i+1] = arr.array[i]
-	}
+func At(arr *Array, index int) any {
+	return arrayGeneric.At(arr, index)
+}
 
-	arr.array[index] = item
+func TryAt(arr *Array, index int) (any, error) {
+	return arrayGeneric.TryAt(arr, index)
 }
 
-func Prepend(arr *array, item any) {
-	Insert(arr, 0, item)
+func Set(arr *Array, index int, item any) {
+	arrayGeneric.Set(arr, index, item)
 }
 
-func Pop(arr *array) (result any) {
-	size := Size(arr)
-	index := size - 1 // last element
-	if size <= 0 {
-		panic("Tried to call Pop() on an empty array.")
-	}
+func TrySet(arr *Array, index int, item any) error {
+	return arrayGeneric.TrySet(arr, index, item)
+}
 
-	result = arr.array[index]
+func Push(arr *Array, item any) {
+	arrayGeneric.Push(arr, item)
+}
 
-	Delete(arr, index)
+func Insert(arr *Array, index int, item any) {
+	arrayGeneric.Insert(arr, index, item)
+}
 
-	return
+func TryInsert(arr *Array, index int, item any) error {
+	return arrayGeneric.TryInsert(arr, index, item)
 }
 
-func Delete(arr *array, index int) {
-	size := Size(arr)
-	cap := Cap(arr)
+func Prepend(arr *Array, item any) {
+	arrayGeneric.Prepend(arr, item)
+}
 
-	if index >= size {
-		panic(fmt.Sprintf(
-			"Index out of bound. The size of the array was %d, but the requested index was %d",
-			size,
-			index,
-		))
-	}
+func Pop(arr *Array) any {
+	return arrayGeneric.Pop(arr)
+}
 
-	for i := index; i < size-1; i++ {
-		arr.array[i] = arr.array[i+1]
-	}
+func TryPop(arr *Array) (any, error) {
+	return arrayGeneric.TryPop(arr)
+}
 
-	arr.array = arr.array[:size-1]
-	arr.size = size - 1
+func Delete(arr *Array, index int) {
+	arrayGeneric.Delete(arr, index)
+}
 
-	if Size(arr)*4 <= cap && cap/2 >= 16 {
-		resize(arr, cap/2)
-	}
+func TryDelete(arr *Array, index int) error {
+	return arrayGeneric.TryDelete(arr, index)
 }
 
-func Find(arr *array, item any) (int, bool) {
-	for i := 0; i < Size(arr); i++ {
-		if At(arr, i) == item {
-			return i, true
-		}
-	}
+func Find(arr *Array, item any) (int, bool) {
+	return arrayGeneric.Find(arr, item)
+}
 
-	return 0, false
+func Remove(arr *Array, item any) bool {
+	return arrayGeneric.Remove(arr, item)
 }
 
-func Remove(arr *array, item any) bool {
-	index, ok := Find(arr, item)
+func All(arr *Array) iter.Seq2[int, any] {
+	return arrayGeneric.All(arr)
+}
 
-	if ok {
-		Delete(arr, index)
-	}
+func Values(arr *Array) iter.Seq[any] {
+	return arrayGeneric.Values(arr)
+}
 
-	return ok
+func Backward(arr *Array) iter.Seq2[int, any] {
+	return arrayGeneric.Backward(arr)
 }
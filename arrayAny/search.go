@@ -0,0 +1,29 @@
+package arrayInt
+
+import (
+	"github.com/kirillrogovoy/computer-science/arrayGeneric"
+)
+
+func BinarySearchFunc(arr *Array, item any, compare func(a, b any) int) (int, bool) {
+	return arrayGeneric.BinarySearchFunc(arr, item, compare)
+}
+
+func LowerBoundFunc(arr *Array, item any, compare func(a, b any) int) int {
+	return arrayGeneric.LowerBoundFunc(arr, item, compare)
+}
+
+func UpperBoundFunc(arr *Array, item any, compare func(a, b any) int) int {
+	return arrayGeneric.UpperBoundFunc(arr, item, compare)
+}
+
+func InsertSortedFunc(arr *Array, item any, compare func(a, b any) int) int {
+	return arrayGeneric.InsertSortedFunc(arr, item, compare)
+}
+
+func RemoveSortedFunc(arr *Array, item any, compare func(a, b any) int) bool {
+	return arrayGeneric.RemoveSortedFunc(arr, item, compare)
+}
+
+func ExponentialSearchFunc(arr *Array, item any, compare func(a, b any) int) (int, bool) {
+	return arrayGeneric.ExponentialSearchFunc(arr, item, compare)
+}
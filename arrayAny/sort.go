@@ -0,0 +1,53 @@
+package arrayInt
+
+import (
+	"github.com/kirillrogovoy/computer-science/arrayGeneric"
+)
+
+type Algorithm = arrayGeneric.Algorithm
+
+const (
+	Intro     = arrayGeneric.Intro
+	Insertion = arrayGeneric.Insertion
+	Merge     = arrayGeneric.Merge
+	Quick     = arrayGeneric.Quick
+	Heap      = arrayGeneric.Heap
+)
+
+var Algorithms = arrayGeneric.Algorithms
+
+func SortFunc(arr *Array, compare func(a, b any) int) {
+	arrayGeneric.SortFunc(arr, compare)
+}
+
+func SortWithFunc(arr *Array, algorithm Algorithm, compare func(a, b any) int) {
+	arrayGeneric.SortWithFunc(arr, algorithm, compare)
+}
+
+func SortStableFunc(arr *Array, compare func(a, b any) int) {
+	arrayGeneric.SortStableFunc(arr, compare)
+}
+
+func InsertionSortFunc(arr *Array, compare func(a, b any) int) {
+	arrayGeneric.InsertionSortFunc(arr, compare)
+}
+
+func MergeSortFunc(arr *Array, compare func(a, b any) int) {
+	arrayGeneric.MergeSortFunc(arr, compare)
+}
+
+func QuickSortFunc(arr *Array, compare func(a, b any) int) {
+	arrayGeneric.QuickSortFunc(arr, compare)
+}
+
+func HeapSortFunc(arr *Array, compare func(a, b any) int) {
+	arrayGeneric.HeapSortFunc(arr, compare)
+}
+
+func IntroSortFunc(arr *Array, compare func(a, b any) int) {
+	arrayGeneric.IntroSortFunc(arr, compare)
+}
+
+func IsSortedFunc(arr *Array, compare func(a, b any) int) bool {
+	return arrayGeneric.IsSortedFunc(arr, compare)
+}
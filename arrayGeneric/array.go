@@ -0,0 +1,254 @@
+package arrayGeneric
+
+import (
+	"fmt"
+
+	"github.com/kirillrogovoy/computer-science/bounds"
+)
+
+var (
+	ErrIndexOutOfRange        = bounds.ErrIndexOutOfRange
+	ErrEmpty                  = bounds.ErrEmpty
+	ErrConcurrentModification = bounds.ErrConcurrentModification
+)
+
+type IndexError = bounds.IndexError
+
+type Array[T any] struct {
+	array  []T
+	size   int
+	cap    int
+	policy GrowthPolicy
+	// mods counts structural modifications so that iterators can fail fast
+	mods int
+}
+
+func Create[T any](initialCap int) *Array[T] {
+	return CreateWithPolicy[T](initialCap, DefaultGrowthPolicy())
+}
+
+func CreateWithPolicy[T any](initialCap int, policy GrowthPolicy) *Array[T] {
+	policy.validate()
+
+	// With the default policy this converts initial capacity into power of 2. Starting from 16
+	cap := policy.Capacity(initialCap)
+
+	return &Array[T]{make([]T, 0, cap), 0, cap, policy, 0}
+}
+
+func Policy[T any](arr *Array[T]) GrowthPolicy {
+	return arr.policy
+}
+
+func Cap[T any](arr *Array[T]) int {
+	return arr.cap
+}
+
+func Size[T any](arr *Array[T]) int {
+	return arr.size
+}
+
+func IsEmpty[T any](arr *Array[T]) bool {
+	return arr.size == 0
+}
+
+func At[T any](arr *Array[T], index int) T {
+	item, err := TryAt(arr, index)
+	if err != nil {
+		panic(err)
+	}
+
+	return item
+}
+
+func TryAt[T any](arr *Array[T], index int) (T, error) {
+	index, err := bounds.Normalize(index, Size(arr))
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return arr.array[index], nil
+}
+
+func Set[T any](arr *Array[T], index int, item T) {
+	if err := TrySet(arr, index, item); err != nil {
+		panic(err)
+	}
+}
+
+func TrySet[T any](arr *Array[T], index int, item T) error {
+	index, err := bounds.Normalize(index, Size(arr))
+	if err != nil {
+		return err
+	}
+
+	arr.array[index] = item
+	return nil
+}
+
+func resize[T any](arr *Array[T], newCapacity int) {
+	if newCapacity == Cap(arr) {
+		return
+	}
+
+	size := Size(arr)
+
+	if newCapacity < size {
+		panic(fmt.Sprintf(
+			"Tried to resize an array with size %d to the capacity %d which is smaller",
+			size,
+			newCapacity,
+		))
+	}
+
+	newArray := make([]T, size, newCapacity)
+
+	// copy elements from the old array to the new one
+	for i := 0; i < size; i++ {
+		newArray[i] = At(arr, i)
+	}
+
+	(*arr).array = newArray
+	(*arr).cap = newCapacity
+	(*arr).mods++
+
+	return
+}
+
+// Reserve makes sure the array can hold n items without resizing
+func Reserve[T any](arr *Array[T], n int) {
+	if n > Cap(arr) {
+		resize(arr, arr.policy.Capacity(n))
+	}
+}
+
+// ShrinkToFit drops the spare capacity down to the MinCapacity of the policy.
+// The next Push on a full array will grow it again
+func ShrinkToFit[T any](arr *Array[T]) {
+	resize(arr, max(Size(arr), arr.policy.MinCapacity))
+}
+
+func Push[T any](arr *Array[T], item T) {
+	cap := Cap(arr)
+	size := Size(arr)
+
+	// we are at full capacity
+	if cap == size {
+		resize(arr, arr.policy.Grow(cap))
+	}
+	arr.array = arr.array[:size+1]
+	arr.size = size + 1
+	arr.mods++
+
+	arr.array[size] = item
+}
+
+func Insert[T any](arr *Array[T], index int, item T) {
+	if err := TryInsert(arr, index, item); err != nil {
+		panic(err)
+	}
+}
+
+func TryInsert[T any](arr *Array[T], index int, item T) error {
+	cap := Cap(arr)
+	size := Size(arr)
+
+	// inserting at index == size is the same as pushing
+	index, err := bounds.NormalizeInsert(index, size)
+	if err != nil {
+		return err
+	}
+
+	// we are at full capacity
+	if cap == size {
+		resize(arr, arr.policy.Grow(cap))
+	}
+	arr.array = arr.array[:size+1]
+	arr.size = size + 1
+	arr.mods++
+
+	for i := Size(arr) - 2; i >= index; i-- {
+		arr.array[i+1] = arr.array[i]
+	}
+
+	arr.array[index] = item
+	return nil
+}
+
+func Prepend[T any](arr *Array[T], item T) {
+	Insert(arr, 0, item)
+}
+
+func Pop[T any](arr *Array[T]) T {
+	result, err := TryPop(arr)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+func TryPop[T any](arr *Array[T]) (result T, err error) {
+	size := Size(arr)
+	index := size - 1 // last element
+	if size <= 0 {
+		return result, bounds.ErrEmpty
+	}
+
+	result = arr.array[index]
+
+	err = TryDelete(arr, index)
+
+	return
+}
+
+func Delete[T any](arr *Array[T], index int) {
+	if err := TryDelete(arr, index); err != nil {
+		panic(err)
+	}
+}
+
+func TryDelete[T any](arr *Array[T], index int) error {
+	size := Size(arr)
+	cap := Cap(arr)
+
+	index, err := bounds.Normalize(index, size)
+	if err != nil {
+		return err
+	}
+
+	for i := index; i < size-1; i++ {
+		arr.array[i] = arr.array[i+1]
+	}
+
+	arr.array = arr.array[:size-1]
+	arr.size = size - 1
+	arr.mods++
+
+	resize(arr, arr.policy.Shrink(Size(arr), cap))
+
+	return nil
+}
+
+// Find requires comparable items, so unlike the rest of the API
+// it is not available for every Array[T]
+func Find[T comparable](arr *Array[T], item T) (int, bool) {
+	for i := 0; i < Size(arr); i++ {
+		if At(arr, i) == item {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+func Remove[T comparable](arr *Array[T], item T) bool {
+	index, ok := Find(arr, item)
+
+	if ok {
+		Delete(arr, index)
+	}
+
+	return ok
+}
@@ -0,0 +1,51 @@
+package arrayGeneric
+
+import (
+	"iter"
+)
+
+// All yields index-item pairs from the first item to the last one.
+// Like the rest of the iterators, it panics with ErrConcurrentModification
+// if the loop body pushes, inserts or deletes items
+func All[T any](arr *Array[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		mods := arr.mods
+		for i := 0; i < Size(arr); i++ {
+			if !yield(i, arr.array[i]) {
+				return
+			}
+			checkMods(arr, mods)
+		}
+	}
+}
+
+// Values yields the items from the first one to the last one
+func Values[T any](arr *Array[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range All(arr) {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Backward yields index-item pairs from the last item to the first one
+func Backward[T any](arr *Array[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		mods := arr.mods
+		for i := Size(arr) - 1; i >= 0; i-- {
+			if !yield(i, arr.array[i]) {
+				return
+			}
+			checkMods(arr, mods)
+		}
+	}
+}
+
+// checkMods fails fast when the array was structurally modified since an iterator saw mods
+func checkMods[T any](arr *Array[T], mods int) {
+	if arr.mods != mods {
+		panic(ErrConcurrentModification)
+	}
+}
@@ -0,0 +1,149 @@
+package arrayGeneric
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"iter"
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	arr := Create[string](16)
+
+	for range All(arr) {
+		t.Error("All() should not yield anything for an empty array")
+	}
+
+	Push(arr, "a")
+	Push(arr, "b")
+	Push(arr, "c")
+
+	indices := []int{}
+	items := []string{}
+	for i, item := range All(arr) {
+		indices = append(indices, i)
+		items = append(items, item)
+	}
+
+	require.Equal(t, []int{0, 1, 2}, indices)
+	require.Equal(t, []string{"a", "b", "c"}, items)
+
+	require.Equal(t, map[int]string{0: "a", 1: "b", 2: "c"}, maps.Collect(arr.All()))
+}
+
+func TestAllBreak(t *testing.T) {
+	arr := Create[int](16)
+	Push(arr, 1)
+	Push(arr, 2)
+	Push(arr, 3)
+
+	visited := 0
+	for _, item := range All(arr) {
+		visited++
+		if item == 2 {
+			break
+		}
+	}
+
+	require.Equal(t, 2, visited)
+}
+
+func TestValues(t *testing.T) {
+	arr := Create[int](16)
+	for i := 0; i < 20; i++ {
+		Push(arr, i)
+	}
+
+	values := slices.Collect(arr.Values())
+	require.Equal(t, arr.array, values)
+	require.Equal(t, 19, slices.Max(slices.Collect(Values(arr))))
+}
+
+func TestBackward(t *testing.T) {
+	arr := Create[int](16)
+	Push(arr, 1)
+	Push(arr, 2)
+	Push(arr, 3)
+
+	indices := []int{}
+	items := []int{}
+	for i, item := range arr.Backward() {
+		indices = append(indices, i)
+		items = append(items, item)
+	}
+
+	require.Equal(t, []int{2, 1, 0}, indices)
+	require.Equal(t, []int{3, 2, 1}, items)
+}
+
+func requireConcurrentModification(t *testing.T, modify func(arr *Array[int]), iterate func(arr *Array[int]) iter.Seq2[int, int]) {
+	arr := Create[int](16)
+	for i := 0; i < 16; i++ {
+		Push(arr, i)
+	}
+
+	defer func() {
+		err, _ := recover().(error)
+		require.Equal(t, true, errors.Is(err, ErrConcurrentModification))
+	}()
+
+	for range iterate(arr) {
+		modify(arr)
+	}
+
+	t.Error("the iterator should panic on a concurrent modification")
+}
+
+func TestConcurrentModification(t *testing.T) {
+	modifications := map[string]func(arr *Array[int]){
+		"Push":        func(arr *Array[int]) { Push(arr, 1) },
+		"Insert":      func(arr *Array[int]) { Insert(arr, 0, 1) },
+		"Delete":      func(arr *Array[int]) { Delete(arr, 0) },
+		"Pop":         func(arr *Array[int]) { Pop(arr) },
+		"Reserve":     func(arr *Array[int]) { Reserve(arr, 100) },
+		"ShrinkToFit": func(arr *Array[int]) { Push(arr, 1); ShrinkToFit(arr) },
+	}
+
+	for name, modify := range modifications {
+		t.Run(name+"/All", func(t *testing.T) {
+			requireConcurrentModification(t, modify, All[int])
+		})
+		t.Run(name+"/Backward", func(t *testing.T) {
+			requireConcurrentModification(t, modify, Backward[int])
+		})
+	}
+}
+
+func TestConcurrentModificationValues(t *testing.T) {
+	arr := Create[int](16)
+	Push(arr, 1)
+	Push(arr, 2)
+
+	require.Panics(t, func() {
+		for range Values(arr) {
+			Push(arr, 3)
+		}
+	})
+}
+
+func TestModificationAllowed(t *testing.T) {
+	arr := Create[int](16)
+	Push(arr, 1)
+	Push(arr, 2)
+
+	// Set doesn't change the structure of the array
+	for i, item := range All(arr) {
+		Set(arr, i, item*10)
+	}
+	require.Equal(t, []int{10, 20}, arr.array)
+
+	// modifying after breaking out of the loop is fine as well
+	for range All(arr) {
+		break
+	}
+	Push(arr, 3)
+
+	require.Equal(t, []int{10, 20, 3}, slices.Collect(Values(arr)))
+}
@@ -0,0 +1,130 @@
+package arrayGeneric
+
+import (
+	"iter"
+)
+
+// Method forms of the package-level functions, so that an Array can satisfy
+// interfaces and be passed around without importing the functions.
+// Find, Remove and the cmp.Ordered flavours of sorting and searching need a
+// constrained T, which a method can't add, so they stay functions only.
+
+func (arr *Array[T]) Cap() int {
+	return Cap(arr)
+}
+
+func (arr *Array[T]) Policy() GrowthPolicy {
+	return Policy(arr)
+}
+
+func (arr *Array[T]) Reserve(n int) {
+	Reserve(arr, n)
+}
+
+func (arr *Array[T]) ShrinkToFit() {
+	ShrinkToFit(arr)
+}
+
+func (arr *Array[T]) Size() int {
+	return Size(arr)
+}
+
+func (arr *Array[T]) IsEmpty() bool {
+	return IsEmpty(arr)
+}
+
+func (arr *Array[T]) At(index int) T {
+	return At(arr, index)
+}
+
+func (arr *Array[T]) TryAt(index int) (T, error) {
+	return TryAt(arr, index)
+}
+
+func (arr *Array[T]) Set(index int, item T) {
+	Set(arr, index, item)
+}
+
+func (arr *Array[T]) TrySet(index int, item T) error {
+	return TrySet(arr, index, item)
+}
+
+func (arr *Array[T]) Push(item T) {
+	Push(arr, item)
+}
+
+func (arr *Array[T]) Insert(index int, item T) {
+	Insert(arr, index, item)
+}
+
+func (arr *Array[T]) TryInsert(index int, item T) error {
+	return TryInsert(arr, index, item)
+}
+
+func (arr *Array[T]) Prepend(item T) {
+	Prepend(arr, item)
+}
+
+func (arr *Array[T]) Pop() T {
+	return Pop(arr)
+}
+
+func (arr *Array[T]) TryPop() (T, error) {
+	return TryPop(arr)
+}
+
+func (arr *Array[T]) Delete(index int) {
+	Delete(arr, index)
+}
+
+func (arr *Array[T]) TryDelete(index int) error {
+	return TryDelete(arr, index)
+}
+
+func (arr *Array[T]) All() iter.Seq2[int, T] {
+	return All(arr)
+}
+
+func (arr *Array[T]) Values() iter.Seq[T] {
+	return Values(arr)
+}
+
+func (arr *Array[T]) Backward() iter.Seq2[int, T] {
+	return Backward(arr)
+}
+
+func (arr *Array[T]) SortFunc(compare func(a, b T) int) {
+	SortFunc(arr, compare)
+}
+
+func (arr *Array[T]) SortWithFunc(algorithm Algorithm, compare func(a, b T) int) {
+	SortWithFunc(arr, algorithm, compare)
+}
+
+func (arr *Array[T]) SortStableFunc(compare func(a, b T) int) {
+	SortStableFunc(arr, compare)
+}
+
+func (arr *Array[T]) IsSortedFunc(compare func(a, b T) int) bool {
+	return IsSortedFunc(arr, compare)
+}
+
+func (arr *Array[T]) BinarySearchFunc(item T, compare func(a, b T) int) (int, bool) {
+	return BinarySearchFunc(arr, item, compare)
+}
+
+func (arr *Array[T]) LowerBoundFunc(item T, compare func(a, b T) int) int {
+	return LowerBoundFunc(arr, item, compare)
+}
+
+func (arr *Array[T]) UpperBoundFunc(item T, compare func(a, b T) int) int {
+	return UpperBoundFunc(arr, item, compare)
+}
+
+func (arr *Array[T]) InsertSortedFunc(item T, compare func(a, b T) int) int {
+	return InsertSortedFunc(arr, item, compare)
+}
+
+func (arr *Array[T]) RemoveSortedFunc(item T, compare func(a, b T) int) bool {
+	return RemoveSortedFunc(arr, item, compare)
+}
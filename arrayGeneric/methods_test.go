@@ -0,0 +1,40 @@
+package arrayGeneric
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestMethods(t *testing.T) {
+	arr := Create[int](16)
+	require.Equal(t, true, arr.IsEmpty())
+	require.Equal(t, 16, arr.Cap())
+
+	arr.Push(1)
+	arr.Push(3)
+	arr.Insert(1, 2)
+	arr.Prepend(0)
+	require.Equal(t, []int{0, 1, 2, 3}, arr.array)
+	require.Equal(t, 4, arr.Size())
+	require.Equal(t, 2, arr.At(2))
+
+	arr.Set(2, 5)
+	require.Equal(t, 5, arr.At(2))
+
+	arr.Delete(0)
+	require.Equal(t, []int{1, 5, 3}, arr.array)
+
+	require.Equal(t, 3, arr.Pop())
+	require.Equal(t, false, arr.IsEmpty())
+}
+
+type stack interface {
+	Push(int)
+	Pop() int
+}
+
+func TestArraySatisfiesInterfaces(t *testing.T) {
+	var s stack = Create[int](16)
+	s.Push(42)
+	require.Equal(t, 42, s.Pop())
+}
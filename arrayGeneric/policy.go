@@ -0,0 +1,87 @@
+package arrayGeneric
+
+import (
+	"fmt"
+	"math"
+)
+
+// GrowthPolicy decides how the capacity of an Array follows its size
+type GrowthPolicy struct {
+	// GrowthFactor multiplies the capacity when a full array needs more room.
+	// Shrinking divides it by the same factor. Must be greater than 1
+	GrowthFactor float64
+	// ShrinkThreshold is the fill ratio at or below which Delete shrinks the array.
+	// 0 disables shrinking
+	ShrinkThreshold float64
+	// MinCapacity is the smallest capacity the array starts with or shrinks to
+	MinCapacity int
+	// Exact makes Create and Reserve use the requested capacity as is
+	// instead of rounding it up to MinCapacity * GrowthFactor^k
+	Exact bool
+}
+
+// DefaultGrowthPolicy doubles a full array, halves it when it's 1/4 full
+// and keeps the capacity a power of 2 starting from 16. It's a function so that
+// every package built on it, like deque and hashtable, gets the same policy
+func DefaultGrowthPolicy() GrowthPolicy {
+	return GrowthPolicy{
+		GrowthFactor:    2,
+		ShrinkThreshold: 0.25,
+		MinCapacity:     16,
+		Exact:           false,
+	}
+}
+
+func (p GrowthPolicy) validate() {
+	if p.GrowthFactor <= 1 {
+		panic(fmt.Sprintf("GrowthFactor must be greater than 1, got %v", p.GrowthFactor))
+	}
+
+	if p.ShrinkThreshold < 0 || p.ShrinkThreshold >= 1 {
+		panic(fmt.Sprintf("ShrinkThreshold must be in [0, 1), got %v", p.ShrinkThreshold))
+	}
+
+	if p.MinCapacity < 0 {
+		panic(fmt.Sprintf("MinCapacity must not be negative, got %d", p.MinCapacity))
+	}
+}
+
+// Capacity returns the capacity the policy gives to an array which has to hold n items
+func (p GrowthPolicy) Capacity(n int) int {
+	if p.Exact {
+		return max(n, p.MinCapacity)
+	}
+
+	cap := p.MinCapacity
+	for cap < n {
+		cap = p.Grow(cap)
+	}
+
+	return cap
+}
+
+// Grow returns the capacity for a full array with capacity cap
+func (p GrowthPolicy) Grow(cap int) int {
+	newCap := int(math.Ceil(float64(cap) * p.GrowthFactor))
+
+	// small capacities and factors close to 1 could get stuck otherwise
+	if newCap <= cap {
+		newCap = cap + 1
+	}
+
+	return max(newCap, p.MinCapacity)
+}
+
+// Shrink returns the capacity after removing an item, which is cap if there's no need to shrink
+func (p GrowthPolicy) Shrink(size int, cap int) int {
+	if p.ShrinkThreshold == 0 || float64(size) > float64(cap)*p.ShrinkThreshold {
+		return cap
+	}
+
+	newCap := int(float64(cap) / p.GrowthFactor)
+	if newCap < p.MinCapacity || newCap < size {
+		return cap
+	}
+
+	return newCap
+}
@@ -0,0 +1,144 @@
+package arrayGeneric
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCapacity(t *testing.T) {
+	// keys are requested sizes and values are expected capacities
+	testMap := map[int]int{
+		-1:  16,
+		0:   16,
+		16:  16,
+		17:  32,
+		100: 128,
+	}
+
+	for n, expected := range testMap {
+		require.Equal(t, expected, DefaultGrowthPolicy().Capacity(n))
+	}
+
+	policy := GrowthPolicy{GrowthFactor: 1.5, MinCapacity: 4}
+	require.Equal(t, 4, policy.Capacity(3))
+	require.Equal(t, 6, policy.Capacity(5))
+	require.Equal(t, 9, policy.Capacity(7))
+
+	policy.Exact = true
+	require.Equal(t, 4, policy.Capacity(3))
+	require.Equal(t, 7, policy.Capacity(7))
+}
+
+func TestCreateWithPolicyPanic(t *testing.T) {
+	invalid := []GrowthPolicy{
+		{GrowthFactor: 1},
+		{GrowthFactor: 2, ShrinkThreshold: -0.5},
+		{GrowthFactor: 2, ShrinkThreshold: 1},
+		{GrowthFactor: 2, MinCapacity: -1},
+	}
+
+	for _, policy := range invalid {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("CreateWithPolicy() should panic on an invalid policy %+v", policy)
+				}
+			}()
+
+			CreateWithPolicy[int](0, policy)
+		}()
+	}
+}
+
+func TestCreateWithPolicy(t *testing.T) {
+	arr := CreateWithPolicy[int](10, GrowthPolicy{GrowthFactor: 1.5, MinCapacity: 2, Exact: true})
+	require.Equal(t, 10, Cap(arr))
+	require.Equal(t, 1.5, Policy(arr).GrowthFactor)
+
+	require.Equal(t, DefaultGrowthPolicy(), Policy(Create[int](0)))
+}
+
+func TestGrowthFactor(t *testing.T) {
+	arr := CreateWithPolicy[int](0, GrowthPolicy{GrowthFactor: 1.5, MinCapacity: 4})
+	require.Equal(t, 4, Cap(arr))
+
+	expectedCaps := []int{4, 4, 4, 4, 6, 6, 9, 9, 9, 14}
+	for i, expected := range expectedCaps {
+		Push(arr, i)
+		require.Equal(t, expected, Cap(arr))
+	}
+}
+
+func TestShrinkThreshold(t *testing.T) {
+	arr := CreateWithPolicy[int](0, GrowthPolicy{GrowthFactor: 2, ShrinkThreshold: 0.5, MinCapacity: 4})
+	for i := 0; i < 16; i++ {
+		Push(arr, i)
+	}
+	require.Equal(t, 16, Cap(arr))
+
+	for i := 0; i < 7; i++ {
+		Delete(arr, 0)
+		require.Equal(t, 16, Cap(arr))
+	}
+
+	// 8 is a half of 16
+	Delete(arr, 0)
+	require.Equal(t, 8, Cap(arr))
+
+	// never shrink below the minimal capacity
+	for Size(arr) > 0 {
+		Pop(arr)
+	}
+	require.Equal(t, 4, Cap(arr))
+}
+
+func TestNoShrink(t *testing.T) {
+	arr := CreateWithPolicy[int](64, GrowthPolicy{GrowthFactor: 2, MinCapacity: 16})
+	Push(arr, 1)
+	Pop(arr)
+
+	require.Equal(t, 64, Cap(arr))
+}
+
+func TestReserve(t *testing.T) {
+	arr := Create[int](16)
+	Push(arr, 1)
+
+	Reserve(arr, 10)
+	require.Equal(t, 16, Cap(arr))
+
+	Reserve(arr, 40)
+	require.Equal(t, 64, Cap(arr))
+	require.Equal(t, []int{1}, arr.array)
+
+	exact := CreateWithPolicy[int](0, GrowthPolicy{GrowthFactor: 2, Exact: true})
+	Reserve(exact, 40)
+	require.Equal(t, 40, Cap(exact))
+}
+
+func TestShrinkToFit(t *testing.T) {
+	arr := Create[int](100)
+	Push(arr, 1)
+	Push(arr, 2)
+
+	// not below the MinCapacity of the policy
+	ShrinkToFit(arr)
+	require.Equal(t, 16, Cap(arr))
+	require.Equal(t, []int{1, 2}, arr.array)
+
+	for i := 3; i <= 20; i++ {
+		Push(arr, i)
+	}
+	ShrinkToFit(arr)
+	require.Equal(t, 20, Cap(arr))
+
+	// grows back following the policy
+	Push(arr, 21)
+	require.Equal(t, 40, Cap(arr))
+
+	empty := CreateWithPolicy[int](10, GrowthPolicy{GrowthFactor: 2, Exact: true})
+	ShrinkToFit(empty)
+	require.Equal(t, 0, Cap(empty))
+	Push(empty, 1)
+	require.Equal(t, 1, Cap(empty))
+}
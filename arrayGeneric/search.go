@@ -0,0 +1,151 @@
+package arrayGeneric
+
+import (
+	"cmp"
+)
+
+// The searches below expect the array to be sorted by the same order they use.
+// Like Find, they return (index, found), but when the item is missing the index
+// is the place where it would be inserted to keep the array sorted
+
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+func BinarySearch[T cmp.Ordered](arr *Array[T], item T) (int, bool) {
+	return BinarySearchFunc(arr, item, cmp.Compare[T])
+}
+
+// BinarySearchFunc returns the index of the first item equal to item
+func BinarySearchFunc[T any](arr *Array[T], item T, compare func(a, b T) int) (int, bool) {
+	index := LowerBoundFunc(arr, item, compare)
+	return index, index < Size(arr) && compare(arr.array[index], item) == 0
+}
+
+func LowerBound[T cmp.Ordered](arr *Array[T], item T) int {
+	return LowerBoundFunc(arr, item, cmp.Compare[T])
+}
+
+// LowerBoundFunc returns the index of the first item which is not less than item
+func LowerBoundFunc[T any](arr *Array[T], item T, compare func(a, b T) int) int {
+	return lowerBound(arr.array[:Size(arr)], item, compare)
+}
+
+func UpperBound[T cmp.Ordered](arr *Array[T], item T) int {
+	return UpperBoundFunc(arr, item, cmp.Compare[T])
+}
+
+// UpperBoundFunc returns the index of the first item which is greater than item
+func UpperBoundFunc[T any](arr *Array[T], item T, compare func(a, b T) int) int {
+	s := arr.array[:Size(arr)]
+
+	lo, hi := 0, len(s)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if compare(s[mid], item) <= 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+
+	return lo
+}
+
+func InsertSorted[T cmp.Ordered](arr *Array[T], item T) int {
+	return InsertSortedFunc(arr, item, cmp.Compare[T])
+}
+
+// InsertSortedFunc inserts item after the equal ones and returns its index
+func InsertSortedFunc[T any](arr *Array[T], item T, compare func(a, b T) int) int {
+	index := UpperBoundFunc(arr, item, compare)
+	Insert(arr, index, item)
+
+	return index
+}
+
+func RemoveSorted[T cmp.Ordered](arr *Array[T], item T) bool {
+	return RemoveSortedFunc(arr, item, cmp.Compare[T])
+}
+
+// RemoveSortedFunc deletes the first item equal to item, the rest stays sorted
+func RemoveSortedFunc[T any](arr *Array[T], item T, compare func(a, b T) int) bool {
+	index, ok := BinarySearchFunc(arr, item, compare)
+
+	if ok {
+		Delete(arr, index)
+	}
+
+	return ok
+}
+
+func ExponentialSearch[T cmp.Ordered](arr *Array[T], item T) (int, bool) {
+	return ExponentialSearchFunc(arr, item, cmp.Compare[T])
+}
+
+// ExponentialSearchFunc doubles the range until it passes item and then runs binary search in it.
+// It takes O(log i) where i is the resulting index, which pays off when items are near the beginning
+func ExponentialSearchFunc[T any](arr *Array[T], item T, compare func(a, b T) int) (int, bool) {
+	s := arr.array[:Size(arr)]
+
+	// s[lo-1] < item, so the result is in [lo, bound]
+	lo, bound := 0, 1
+	for bound <= len(s) && compare(s[bound-1], item) < 0 {
+		lo = bound
+		bound *= 2
+	}
+	hi := min(bound, len(s))
+
+	index := lo + lowerBound(s[lo:hi], item, compare)
+	return index, index < len(s) && compare(s[index], item) == 0
+}
+
+// InterpolationSearch guesses the position of item assuming the values are spread evenly.
+// It takes O(log log n) on uniformly distributed values, but degrades to O(n) on skewed ones
+func InterpolationSearch[T Integer](arr *Array[T], item T) (int, bool) {
+	s := arr.array[:Size(arr)]
+
+	// the result is in [lo, hi]
+	lo, hi := 0, len(s)
+	for lo < hi {
+		first, last := s[lo], s[hi-1]
+		if item <= first {
+			hi = lo
+			break
+		}
+		if item > last {
+			lo = hi
+			break
+		}
+
+		// first < item <= last here, so the fraction is in (0, 1]
+		fraction := (float64(item) - float64(first)) / (float64(last) - float64(first))
+		pos := lo + int(fraction*float64(hi-1-lo))
+
+		// float rounding on huge values must not throw us out of the range
+		pos = max(lo, min(pos, hi-1))
+
+		if s[pos] < item {
+			lo = pos + 1
+		} else {
+			hi = pos
+		}
+	}
+
+	return lo, lo < len(s) && s[lo] == item
+}
+
+func lowerBound[T any](s []T, item T, compare func(a, b T) int) int {
+	lo, hi := 0, len(s)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if compare(s[mid], item) < 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+
+	return lo
+}
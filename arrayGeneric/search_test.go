@@ -0,0 +1,171 @@
+package arrayGeneric
+
+import (
+	"cmp"
+	"github.com/stretchr/testify/require"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+var searches = map[string]func(arr *Array[int], item int) (int, bool){
+	"BinarySearch":        BinarySearch[int],
+	"ExponentialSearch":   ExponentialSearch[int],
+	"InterpolationSearch": InterpolationSearch[int],
+}
+
+func TestSearches(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+
+	for name, search := range searches {
+		t.Run(name, func(t *testing.T) {
+			arr := Create[int](16)
+
+			// sorted, with duplicates and gaps
+			items := []int{}
+			for i := 0; i < 300; i++ {
+				items = append(items, random.Intn(500))
+			}
+			slices.Sort(items)
+			for _, item := range items {
+				Push(arr, item)
+			}
+
+			for item := -1; item <= 501; item++ {
+				expectedIndex, expectedFound := slices.BinarySearch(items, item)
+				index, found := search(arr, item)
+
+				require.Equal(t, expectedFound, found, "item %d", item)
+				require.Equal(t, expectedIndex, index, "item %d", item)
+			}
+		})
+	}
+}
+
+func TestSearchesEdgeCases(t *testing.T) {
+	for name, search := range searches {
+		arr := Create[int](16)
+
+		index, found := search(arr, 1)
+		require.Equal(t, false, found, name)
+		require.Equal(t, 0, index, name)
+
+		Push(arr, 5)
+
+		index, found = search(arr, 5)
+		require.Equal(t, true, found, name)
+		require.Equal(t, 0, index, name)
+
+		index, found = search(arr, 6)
+		require.Equal(t, false, found, name)
+		require.Equal(t, 1, index, name)
+
+		Push(arr, 5)
+		Push(arr, 5)
+
+		// the first of the equal items
+		index, found = search(arr, 5)
+		require.Equal(t, true, found, name)
+		require.Equal(t, 0, index, name)
+	}
+}
+
+func TestInterpolationSearchHugeValues(t *testing.T) {
+	arr := Create[int64](16)
+	items := []int64{math.MinInt64, -1, 0, 1 << 60, math.MaxInt64 - 1, math.MaxInt64}
+	for _, item := range items {
+		Push(arr, item)
+	}
+
+	for i, item := range items {
+		index, found := InterpolationSearch(arr, item)
+		require.Equal(t, true, found)
+		require.Equal(t, i, index)
+	}
+
+	index, found := InterpolationSearch(arr, 5)
+	require.Equal(t, false, found)
+	require.Equal(t, 3, index)
+}
+
+func TestInterpolationSearchUnsigned(t *testing.T) {
+	arr := Create[uint8](16)
+	for _, item := range []uint8{0, 10, 20, 255} {
+		Push(arr, item)
+	}
+
+	index, found := InterpolationSearch(arr, uint8(20))
+	require.Equal(t, true, found)
+	require.Equal(t, 2, index)
+}
+
+func TestBounds(t *testing.T) {
+	arr := fromSlice([]int{1, 2, 2, 2, 3, 5})
+
+	require.Equal(t, 0, LowerBound(arr, 0))
+	require.Equal(t, 0, UpperBound(arr, 0))
+	require.Equal(t, 1, LowerBound(arr, 2))
+	require.Equal(t, 4, UpperBound(arr, 2))
+	require.Equal(t, 5, LowerBound(arr, 4))
+	require.Equal(t, 5, UpperBound(arr, 4))
+	require.Equal(t, 6, LowerBound(arr, 6))
+	require.Equal(t, 6, UpperBound(arr, 6))
+}
+
+func TestInsertSorted(t *testing.T) {
+	arr := Create[int](16)
+
+	for _, item := range []int{5, 1, 4, 1, 3, 9, 2} {
+		InsertSorted(arr, item)
+		require.Equal(t, true, IsSorted(arr))
+	}
+
+	require.Equal(t, []int{1, 1, 2, 3, 4, 5, 9}, arr.array)
+	require.Equal(t, 7, InsertSorted(arr, 10))
+	require.Equal(t, 0, InsertSorted(arr, 0))
+}
+
+func TestInsertSortedStable(t *testing.T) {
+	arr := Create[pair](16)
+	byKey := func(a, b pair) int {
+		return cmp.Compare(a.key, b.key)
+	}
+
+	arr.InsertSortedFunc(pair{1, 0}, byKey)
+	arr.InsertSortedFunc(pair{2, 1}, byKey)
+	arr.InsertSortedFunc(pair{1, 2}, byKey)
+
+	require.Equal(t, []pair{{1, 0}, {1, 2}, {2, 1}}, arr.array)
+}
+
+func TestRemoveSorted(t *testing.T) {
+	arr := fromSlice([]int{1, 2, 2, 3})
+
+	require.Equal(t, false, RemoveSorted(arr, 4))
+	require.Equal(t, true, RemoveSorted(arr, 2))
+	require.Equal(t, []int{1, 2, 3}, arr.array)
+	require.Equal(t, true, RemoveSorted(arr, 1))
+	require.Equal(t, true, RemoveSorted(arr, 3))
+	require.Equal(t, []int{2}, arr.array)
+}
+
+func TestSearchFunc(t *testing.T) {
+	descending := func(a, b int) int {
+		return cmp.Compare(b, a)
+	}
+	arr := fromSlice([]int{9, 7, 7, 3})
+
+	index, found := arr.BinarySearchFunc(7, descending)
+	require.Equal(t, true, found)
+	require.Equal(t, 1, index)
+
+	index, found = ExponentialSearchFunc(arr, 5, descending)
+	require.Equal(t, false, found)
+	require.Equal(t, 3, index)
+
+	require.Equal(t, 1, arr.LowerBoundFunc(7, descending))
+	require.Equal(t, 3, arr.UpperBoundFunc(7, descending))
+	require.Equal(t, true, arr.RemoveSortedFunc(9, descending))
+	require.Equal(t, []int{7, 7, 3}, arr.array)
+}
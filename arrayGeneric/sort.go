@@ -0,0 +1,300 @@
+package arrayGeneric
+
+import (
+	"cmp"
+	"math/bits"
+)
+
+// Algorithm selects one of the sorting algorithms.
+// All of them are exposed by name so that they can be compared to each other
+type Algorithm int
+
+const (
+	// Intro is quick sort which falls back to heap sort when the recursion gets
+	// too deep and to insertion sort on small parts. It is what Sort uses
+	Intro Algorithm = iota
+	Insertion
+	// Merge is the only stable O(n log n) algorithm here. It is what SortStable uses
+	Merge
+	// Quick picks the pivot as a median of the first, the middle and the last items
+	Quick
+	Heap
+)
+
+var Algorithms = []Algorithm{Insertion, Merge, Quick, Heap, Intro}
+
+func (algorithm Algorithm) String() string {
+	switch algorithm {
+	case Intro:
+		return "intro"
+	case Insertion:
+		return "insertion"
+	case Merge:
+		return "merge"
+	case Quick:
+		return "quick"
+	case Heap:
+		return "heap"
+	default:
+		return "unknown"
+	}
+}
+
+// parts of intro sort smaller than this are sorted with insertion sort
+const insertionSortThreshold = 12
+
+func SortWithFunc[T any](arr *Array[T], algorithm Algorithm, compare func(a, b T) int) {
+	s := arr.array[:Size(arr)]
+
+	switch algorithm {
+	case Intro:
+		introSort(s, compare, 2*bits.Len(uint(len(s))))
+	case Insertion:
+		insertionSort(s, compare)
+	case Merge:
+		mergeSort(s, compare)
+	case Quick:
+		quickSort(s, compare)
+	case Heap:
+		heapSort(s, compare)
+	default:
+		panic("Unknown sorting algorithm " + algorithm.String())
+	}
+
+	// the items keep their places in memory, but iterators would still see a mix of old and new orders
+	arr.mods++
+}
+
+func SortWith[T cmp.Ordered](arr *Array[T], algorithm Algorithm) {
+	SortWithFunc(arr, algorithm, cmp.Compare[T])
+}
+
+func Sort[T cmp.Ordered](arr *Array[T]) {
+	SortWith(arr, Intro)
+}
+
+func SortFunc[T any](arr *Array[T], compare func(a, b T) int) {
+	SortWithFunc(arr, Intro, compare)
+}
+
+func SortStable[T cmp.Ordered](arr *Array[T]) {
+	SortWith(arr, Merge)
+}
+
+func SortStableFunc[T any](arr *Array[T], compare func(a, b T) int) {
+	SortWithFunc(arr, Merge, compare)
+}
+
+func InsertionSort[T cmp.Ordered](arr *Array[T]) {
+	SortWith(arr, Insertion)
+}
+
+func InsertionSortFunc[T any](arr *Array[T], compare func(a, b T) int) {
+	SortWithFunc(arr, Insertion, compare)
+}
+
+func MergeSort[T cmp.Ordered](arr *Array[T]) {
+	SortWith(arr, Merge)
+}
+
+func MergeSortFunc[T any](arr *Array[T], compare func(a, b T) int) {
+	SortWithFunc(arr, Merge, compare)
+}
+
+func QuickSort[T cmp.Ordered](arr *Array[T]) {
+	SortWith(arr, Quick)
+}
+
+func QuickSortFunc[T any](arr *Array[T], compare func(a, b T) int) {
+	SortWithFunc(arr, Quick, compare)
+}
+
+func HeapSort[T cmp.Ordered](arr *Array[T]) {
+	SortWith(arr, Heap)
+}
+
+func HeapSortFunc[T any](arr *Array[T], compare func(a, b T) int) {
+	SortWithFunc(arr, Heap, compare)
+}
+
+func IntroSort[T cmp.Ordered](arr *Array[T]) {
+	SortWith(arr, Intro)
+}
+
+func IntroSortFunc[T any](arr *Array[T], compare func(a, b T) int) {
+	SortWithFunc(arr, Intro, compare)
+}
+
+func IsSorted[T cmp.Ordered](arr *Array[T]) bool {
+	return IsSortedFunc(arr, cmp.Compare[T])
+}
+
+func IsSortedFunc[T any](arr *Array[T], compare func(a, b T) int) bool {
+	for i := 1; i < Size(arr); i++ {
+		if compare(arr.array[i-1], arr.array[i]) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func insertionSort[T any](s []T, compare func(a, b T) int) {
+	for i := 1; i < len(s); i++ {
+		item := s[i]
+
+		// shift greater items right until there's a place for the current one
+		j := i
+		for ; j > 0 && compare(s[j-1], item) > 0; j-- {
+			s[j] = s[j-1]
+		}
+
+		s[j] = item
+	}
+}
+
+func mergeSort[T any](s []T, compare func(a, b T) int) {
+	buf := make([]T, len(s))
+	mergeSortRecursive(s, buf, compare)
+}
+
+func mergeSortRecursive[T any](s []T, buf []T, compare func(a, b T) int) {
+	if len(s) < 2 {
+		return
+	}
+
+	mid := len(s) / 2
+	mergeSortRecursive(s[:mid], buf[:mid], compare)
+	mergeSortRecursive(s[mid:], buf[mid:], compare)
+
+	// the halves are already in order
+	if compare(s[mid-1], s[mid]) <= 0 {
+		return
+	}
+
+	copy(buf, s)
+	left, right := buf[:mid], buf[mid:len(s)]
+	i, j := 0, 0
+	for k := range s {
+		// take from the left half on ties, this is what makes the sort stable
+		if j == len(right) || (i < len(left) && compare(left[i], right[j]) <= 0) {
+			s[k] = left[i]
+			i++
+		} else {
+			s[k] = right[j]
+			j++
+		}
+	}
+}
+
+func quickSort[T any](s []T, compare func(a, b T) int) {
+	for len(s) > 1 {
+		p := partition(s, compare)
+
+		// recurse into the smaller part and loop over the bigger one to keep the stack O(log n)
+		if p < len(s)-p {
+			quickSort(s[:p], compare)
+			s = s[p+1:]
+		} else {
+			quickSort(s[p+1:], compare)
+			s = s[:p]
+		}
+	}
+}
+
+func introSort[T any](s []T, compare func(a, b T) int, depth int) {
+	for len(s) > insertionSortThreshold {
+		// quick sort is going quadratic, switch to the guaranteed O(n log n)
+		if depth == 0 {
+			heapSort(s, compare)
+			return
+		}
+		depth--
+
+		p := partition(s, compare)
+		if p < len(s)-p {
+			introSort(s[:p], compare, depth)
+			s = s[p+1:]
+		} else {
+			introSort(s[p+1:], compare, depth)
+			s = s[:p]
+		}
+	}
+
+	insertionSort(s, compare)
+}
+
+// partition puts the median of the first, the middle and the last items in its final place
+// and returns its index. Items to the left are not greater and items to the right are not less
+func partition[T any](s []T, compare func(a, b T) int) int {
+	hi := len(s) - 1
+	mid := hi / 2
+
+	// order the three candidates so that s[mid] is the median
+	if compare(s[mid], s[0]) < 0 {
+		s[0], s[mid] = s[mid], s[0]
+	}
+	if compare(s[hi], s[0]) < 0 {
+		s[0], s[hi] = s[hi], s[0]
+	}
+	if compare(s[hi], s[mid]) < 0 {
+		s[mid], s[hi] = s[hi], s[mid]
+	}
+
+	s[0], s[mid] = s[mid], s[0]
+	pivot := s[0]
+
+	// items equal to the pivot stop both pointers, so duplicates are split evenly
+	i, j := 1, hi
+	for {
+		for i <= j && compare(s[i], pivot) < 0 {
+			i++
+		}
+		for i <= j && compare(s[j], pivot) > 0 {
+			j--
+		}
+		if i >= j {
+			break
+		}
+
+		s[i], s[j] = s[j], s[i]
+		i++
+		j--
+	}
+
+	s[0], s[j] = s[j], s[0]
+	return j
+}
+
+func heapSort[T any](s []T, compare func(a, b T) int) {
+	// build a max-heap
+	for i := len(s)/2 - 1; i >= 0; i-- {
+		siftDown(s, i, len(s), compare)
+	}
+
+	// move the max to the end one by one
+	for end := len(s) - 1; end > 0; end-- {
+		s[0], s[end] = s[end], s[0]
+		siftDown(s, 0, end, compare)
+	}
+}
+
+func siftDown[T any](s []T, root int, end int, compare func(a, b T) int) {
+	for {
+		child := 2*root + 1
+		if child >= end {
+			return
+		}
+
+		if child+1 < end && compare(s[child], s[child+1]) < 0 {
+			child++
+		}
+
+		if compare(s[root], s[child]) >= 0 {
+			return
+		}
+
+		s[root], s[child] = s[child], s[root]
+		root = child
+	}
+}
@@ -0,0 +1,188 @@
+package arrayGeneric
+
+import (
+	"cmp"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func sortInputs() map[string][]int {
+	random := rand.New(rand.NewSource(42))
+
+	randomItems := make([]int, 1000)
+	for i := range randomItems {
+		randomItems[i] = random.Intn(1000000)
+	}
+
+	fewUnique := make([]int, 1000)
+	for i := range fewUnique {
+		fewUnique[i] = random.Intn(3)
+	}
+
+	ascending := make([]int, 500)
+	descending := make([]int, 500)
+	for i := range ascending {
+		ascending[i] = i
+		descending[i] = -i
+	}
+
+	// ascending then descending, a classic bad case for naive pivot choice
+	organPipe := append(slices.Clone(ascending), descending...)
+
+	return map[string][]int{
+		"empty":      {},
+		"one":        {1},
+		"two":        {2, 1},
+		"three":      {3, 1, 2},
+		"random":     randomItems,
+		"few unique": fewUnique,
+		"ascending":  ascending,
+		"descending": descending,
+		"organ pipe": organPipe,
+		"equal":      make([]int, 300),
+	}
+}
+
+func fromSlice[T any](items []T) *Array[T] {
+	arr := Create[T](len(items))
+	for _, item := range items {
+		Push(arr, item)
+	}
+	return arr
+}
+
+func TestSortWith(t *testing.T) {
+	for _, algorithm := range Algorithms {
+		for name, input := range sortInputs() {
+			t.Run(algorithm.String()+"/"+name, func(t *testing.T) {
+				arr := fromSlice(input)
+				SortWith(arr, algorithm)
+
+				expected := slices.Clone(input)
+				slices.Sort(expected)
+
+				require.Equal(t, expected, arr.array)
+				require.Equal(t, true, IsSorted(arr))
+			})
+		}
+	}
+}
+
+func TestSortFunc(t *testing.T) {
+	descending := func(a, b int) int {
+		return cmp.Compare(b, a)
+	}
+
+	for _, algorithm := range Algorithms {
+		arr := fromSlice([]int{3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5})
+		SortWithFunc(arr, algorithm, descending)
+
+		require.Equal(t, []int{9, 6, 5, 5, 5, 4, 3, 3, 2, 1, 1}, arr.array, algorithm.String())
+		require.Equal(t, true, IsSortedFunc(arr, descending))
+		require.Equal(t, false, IsSorted(arr))
+	}
+}
+
+func TestNamedSorts(t *testing.T) {
+	sorts := map[string]func(arr *Array[int]){
+		"Sort":          Sort[int],
+		"SortStable":    SortStable[int],
+		"InsertionSort": InsertionSort[int],
+		"MergeSort":     MergeSort[int],
+		"QuickSort":     QuickSort[int],
+		"HeapSort":      HeapSort[int],
+		"IntroSort":     IntroSort[int],
+		"SortFunc": func(arr *Array[int]) {
+			arr.SortFunc(cmp.Compare[int])
+		},
+	}
+
+	for name, sort := range sorts {
+		arr := fromSlice([]int{5, 2, 8, 1, 9, 3})
+		sort(arr)
+		require.Equal(t, []int{1, 2, 3, 5, 8, 9}, arr.array, name)
+	}
+}
+
+type pair struct {
+	key   int
+	order int
+}
+
+func TestSortStable(t *testing.T) {
+	random := rand.New(rand.NewSource(7))
+
+	items := make([]pair, 500)
+	for i := range items {
+		items[i] = pair{random.Intn(10), i}
+	}
+
+	byKey := func(a, b pair) int {
+		return cmp.Compare(a.key, b.key)
+	}
+
+	for _, algorithm := range []Algorithm{Merge, Insertion} {
+		arr := fromSlice(items)
+		SortWithFunc(arr, algorithm, byKey)
+
+		for i := 1; i < Size(arr); i++ {
+			prev, cur := At(arr, i-1), At(arr, i)
+			if prev.key == cur.key {
+				require.Less(t, prev.order, cur.order, algorithm.String()+" should keep equal items in order")
+			}
+		}
+	}
+
+	arr := fromSlice(items)
+	SortStableFunc(arr, byKey)
+	require.Equal(t, true, slices.IsSortedFunc(arr.array, func(a, b pair) int {
+		return cmp.Or(byKey(a, b), cmp.Compare(a.order, b.order))
+	}))
+}
+
+func TestIntroSortFallback(t *testing.T) {
+	items := []int{5, 3, 9, 1, 7, 2, 8, 6, 4, 0, 11, 15, 13, 12, 14, 10}
+
+	// zero depth goes straight to heap sort
+	introSort(items, cmp.Compare[int], 0)
+	require.Equal(t, true, slices.IsSorted(items))
+}
+
+func TestSortOnlySortsItems(t *testing.T) {
+	arr := Create[int](16)
+	Push(arr, 3)
+	Push(arr, 2)
+	Push(arr, 1)
+
+	// leftovers after Delete must not be pulled back in
+	Push(arr, -1)
+	Pop(arr)
+
+	Sort(arr)
+	require.Equal(t, []int{1, 2, 3}, arr.array)
+}
+
+func TestSortPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("SortWith() should panic on an unknown algorithm")
+		}
+	}()
+
+	SortWith(Create[int](16), Algorithm(42))
+}
+
+func TestIsSorted(t *testing.T) {
+	require.Equal(t, true, IsSorted(Create[int](16)))
+	require.Equal(t, true, IsSorted(fromSlice([]int{1})))
+	require.Equal(t, true, IsSorted(fromSlice([]int{1, 1, 2})))
+	require.Equal(t, false, IsSorted(fromSlice([]int{2, 1})))
+}
+
+func TestAlgorithmString(t *testing.T) {
+	require.Equal(t, "intro", Intro.String())
+	require.Equal(t, "heap", Heap.String())
+	require.Equal(t, "unknown", Algorithm(42).String())
+}
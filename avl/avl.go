@@ -0,0 +1,346 @@
+// Package avl is an ordered map on an AVL tree: a binary search tree where the
+// heights of the two subtrees of every node differ by at most one. Puts and
+// deletes restore that with rotations on the way back up, so the height stays
+// below 1.44 log n and every operation is O(log n) whatever the order of keys.
+// Nodes also keep the size of their subtree, which makes it an order-statistic tree
+package avl
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/kirillrogovoy/computer-science/bounds"
+	"github.com/kirillrogovoy/computer-science/internal/bintree"
+	"github.com/kirillrogovoy/computer-science/ordered"
+)
+
+var ErrConcurrentModification = bounds.ErrConcurrentModification
+
+type node[K, V any] struct {
+	key   K
+	value V
+	left  *node[K, V]
+	right *node[K, V]
+	// height is the number of nodes on the longest path down to a leaf, 1 for a leaf
+	height int
+	// size is the number of nodes in the subtree, which makes Select and Rank O(log n)
+	size int
+}
+
+// The accessors let the walks shared by the trees in internal/bintree read nodes
+
+func (n *node[K, V]) Key() K {
+	return n.key
+}
+
+func (n *node[K, V]) Value() V {
+	return n.value
+}
+
+func (n *node[K, V]) Left() *node[K, V] {
+	return n.left
+}
+
+func (n *node[K, V]) Right() *node[K, V] {
+	return n.right
+}
+
+type Tree[K, V any] struct {
+	root    *node[K, V]
+	size    int
+	compare func(a, b K) int
+	// mods counts structural modifications so that iterators can fail fast
+	mods int
+}
+
+var _ ordered.OrderedMap[int, int] = (*Tree[int, int])(nil)
+var _ ordered.Validator = (*Tree[int, int])(nil)
+
+// New returns a tree of keys which can be compared with <, like ints
+func New[K cmp.Ordered, V any]() *Tree[K, V] {
+	return NewFunc[K, V](cmp.Compare[K])
+}
+
+// NewFunc returns a tree ordering keys with compare, so they can be of any type
+func NewFunc[K, V any](compare func(a, b K) int) *Tree[K, V] {
+	return &Tree[K, V]{nil, 0, compare, 0}
+}
+
+func Len[K, V any](t *Tree[K, V]) int {
+	return t.size
+}
+
+func Empty[K, V any](t *Tree[K, V]) bool {
+	return t.size == 0
+}
+
+// Height returns the number of nodes on the longest path from the root to a leaf,
+// 0 for an empty tree
+func Height[K, V any](t *Tree[K, V]) int {
+	return height(t.root)
+}
+
+func find[K, V any](t *Tree[K, V], key K) *node[K, V] {
+	n := t.root
+	for n != nil {
+		c := t.compare(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+
+	return nil
+}
+
+func Get[K, V any](t *Tree[K, V], key K) (V, bool) {
+	n := find(t, key)
+	if n == nil {
+		var zero V
+		return zero, false
+	}
+
+	return n.value, true
+}
+
+func Contains[K, V any](t *Tree[K, V], key K) bool {
+	return find(t, key) != nil
+}
+
+// Put sets the value of key and tells if the key is new
+func Put[K, V any](t *Tree[K, V], key K, value V) bool {
+	var added bool
+	t.root, added = put(t, t.root, key, value)
+	if added {
+		t.size++
+		t.mods++
+	}
+
+	return added
+}
+
+func put[K, V any](t *Tree[K, V], n *node[K, V], key K, value V) (*node[K, V], bool) {
+	if n == nil {
+		return &node[K, V]{key: key, value: value, height: 1, size: 1}, true
+	}
+
+	var added bool
+	c := t.compare(key, n.key)
+	switch {
+	case c < 0:
+		n.left, added = put(t, n.left, key, value)
+	case c > 0:
+		n.right, added = put(t, n.right, key, value)
+	default:
+		n.value = value
+		return n, false
+	}
+
+	return rebalance(n), added
+}
+
+// Delete removes key and tells if it was there
+func Delete[K, V any](t *Tree[K, V], key K) bool {
+	var deleted bool
+	t.root, deleted = remove(t, t.root, key)
+	if deleted {
+		t.size--
+		t.mods++
+	}
+
+	return deleted
+}
+
+func remove[K, V any](t *Tree[K, V], n *node[K, V], key K) (*node[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var deleted bool
+	c := t.compare(key, n.key)
+	switch {
+	case c < 0:
+		n.left, deleted = remove(t, n.left, key)
+	case c > 0:
+		n.right, deleted = remove(t, n.right, key)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+
+		// the successor takes the place of n
+		var successor *node[K, V]
+		n.right, successor = removeMin(n.right)
+		successor.left, successor.right = n.left, n.right
+		n = successor
+		deleted = true
+	}
+
+	return rebalance(n), deleted
+}
+
+// removeMin unlinks the leftmost node under n and returns what's left and that node
+func removeMin[K, V any](n *node[K, V]) (*node[K, V], *node[K, V]) {
+	if n.left == nil {
+		return n.right, n
+	}
+
+	var min *node[K, V]
+	n.left, min = removeMin(n.left)
+	return rebalance(n), min
+}
+
+func height[K, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+
+	return n.height
+}
+
+func size[K, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+
+	return n.size
+}
+
+// update recalculates what n keeps about its subtree from its children
+func update[K, V any](n *node[K, V]) {
+	n.height = 1 + max(height(n.left), height(n.right))
+	n.size = 1 + size(n.left) + size(n.right)
+}
+
+func balanceFactor[K, V any](n *node[K, V]) int {
+	return height(n.left) - height(n.right)
+}
+
+// rebalance updates n after one of its subtrees changed and rotates it if
+// the heights of the subtrees now differ by 2. It returns the new root of the subtree
+func rebalance[K, V any](n *node[K, V]) *node[K, V] {
+	update(n)
+
+	switch balanceFactor(n) {
+	case 2:
+		// left-right case: make it left-left first
+		if balanceFactor(n.left) < 0 {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case -2:
+		// right-left case: make it right-right first
+		if balanceFactor(n.right) > 0 {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	default:
+		return n
+	}
+}
+
+// rotateRight lifts the left child of n into its place:
+//
+//	    n            l
+//	   / \          / \
+//	  l   c  ==>   a   n
+//	 / \              / \
+//	a   b            b   c
+func rotateRight[K, V any](n *node[K, V]) *node[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+
+	update(n)
+	update(l)
+	return l
+}
+
+// rotateLeft is the mirror image of rotateRight
+func rotateLeft[K, V any](n *node[K, V]) *node[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+
+	update(n)
+	update(r)
+	return r
+}
+
+// Min returns the smallest key and its value
+func Min[K, V any](t *Tree[K, V]) (K, V, bool) {
+	return bintree.Min[K, V](t.root)
+}
+
+// Max returns the greatest key and its value
+func Max[K, V any](t *Tree[K, V]) (K, V, bool) {
+	return bintree.Max[K, V](t.root)
+}
+
+// Floor returns the greatest key which is less than or equal to key
+func Floor[K, V any](t *Tree[K, V], key K) (K, V, bool) {
+	return bintree.Floor[K, V](t.root, key, t.compare)
+}
+
+// Ceiling returns the smallest key which is greater than or equal to key
+func Ceiling[K, V any](t *Tree[K, V], key K) (K, V, bool) {
+	return bintree.Ceiling[K, V](t.root, key, t.compare)
+}
+
+// Validate checks that the keys are ordered, that every node knows its height and size
+// and that the heights of its subtrees differ by at most one
+func Validate[K, V any](t *Tree[K, V]) error {
+	count, err := validate(t, t.root, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if count != t.size {
+		return fmt.Errorf("the tree has %d nodes, but its size is %d", count, t.size)
+	}
+
+	return nil
+}
+
+// validate checks the subtree of n, whose keys must be strictly between the keys of lo
+// and hi if those aren't nil, and returns the number of nodes in it
+func validate[K, V any](t *Tree[K, V], n, lo, hi *node[K, V]) (int, error) {
+	if n == nil {
+		return 0, nil
+	}
+
+	if (lo != nil && t.compare(n.key, lo.key) <= 0) || (hi != nil && t.compare(n.key, hi.key) >= 0) {
+		return 0, fmt.Errorf("the key %v is out of order", n.key)
+	}
+
+	left, err := validate(t, n.left, lo, n)
+	if err != nil {
+		return 0, err
+	}
+
+	right, err := validate(t, n.right, n, hi)
+	if err != nil {
+		return 0, err
+	}
+
+	if expected := 1 + max(height(n.left), height(n.right)); n.height != expected {
+		return 0, fmt.Errorf("the node %v has the height %d instead of %d", n.key, n.height, expected)
+	}
+
+	if expected := 1 + left + right; n.size != expected {
+		return 0, fmt.Errorf("the node %v has the size %d instead of %d", n.key, n.size, expected)
+	}
+
+	if factor := balanceFactor(n); factor < -1 || factor > 1 {
+		return 0, fmt.Errorf("the node %v has the balance factor %d", n.key, factor)
+	}
+
+	return 1 + left + right, nil
+}
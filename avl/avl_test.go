@@ -0,0 +1,109 @@
+package avl
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/kirillrogovoy/computer-science/ordered"
+	"github.com/kirillrogovoy/computer-science/ordered/orderedtest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConformance(t *testing.T) {
+	orderedtest.Run(t, func() ordered.OrderedMap[int, int] {
+		return New[int, int]()
+	})
+}
+
+func TestRotations(t *testing.T) {
+	cases := map[string][]int{
+		"left-left":   {3, 2, 1},
+		"right-right": {1, 2, 3},
+		"left-right":  {3, 1, 2},
+		"right-left":  {1, 3, 2},
+	}
+
+	for name, keys := range cases {
+		t.Run(name, func(t *testing.T) {
+			tree := New[int, int]()
+			for _, key := range keys {
+				tree.Put(key, key)
+			}
+
+			// every case ends up with 2 at the root and 1 and 3 as its children
+			require.Equal(t, 2, tree.root.key)
+			require.Equal(t, 1, tree.root.left.key)
+			require.Equal(t, 3, tree.root.right.key)
+			require.Equal(t, 2, tree.Height())
+		})
+	}
+}
+
+func TestHeightOnSortedInput(t *testing.T) {
+	tree := New[int, int]()
+	for key := 0; key < 100000; key++ {
+		tree.Put(key, key)
+	}
+
+	// an AVL tree is never higher than about 1.44 log(n)
+	bound := int(1.4405*math.Log2(float64(tree.Len()+2)) - 0.3277)
+	require.LessOrEqual(t, tree.Height(), bound)
+	require.NoError(t, tree.Validate())
+}
+
+func TestValidate(t *testing.T) {
+	tree := New[int, int]()
+	for key := range 7 {
+		tree.Put(key, key)
+	}
+	require.NoError(t, Validate(tree))
+
+	tree.root.left.key = 100
+	require.EqualError(t, Validate(tree), "the key 100 is out of order")
+	tree.root.left.key = 1
+
+	tree.root.height = 5
+	require.EqualError(t, Validate(tree), "the node 3 has the height 5 instead of 3")
+	tree.root.height = 3
+
+	tree.root.right.size = 1
+	require.EqualError(t, Validate(tree), "the node 5 has the size 1 instead of 3")
+	tree.root.right.size = 3
+
+	// hang a chain off the leftmost leaf without rebalancing
+	leaf := tree.root.left.left
+	leaf.left = &node[int, int]{key: -2, height: 2, size: 2, left: &node[int, int]{key: -3, height: 1, size: 1}}
+	leaf.height, leaf.size = 3, 3
+	tree.root.left.height, tree.root.left.size = 4, 5
+	tree.root.height, tree.root.size = 5, 9
+	require.EqualError(t, Validate(tree), "the node 0 has the balance factor 2")
+
+	leaf.left = nil
+	leaf.height, leaf.size = 1, 1
+	tree.root.left.height, tree.root.left.size = 2, 3
+	tree.root.height, tree.root.size = 3, 7
+	tree.size++
+	require.EqualError(t, Validate(tree), "the tree has 7 nodes, but its size is 8")
+}
+
+type version struct {
+	major, minor int
+}
+
+func TestComparator(t *testing.T) {
+	tree := NewFunc[version, string](func(a, b version) int {
+		return cmp.Or(cmp.Compare(a.major, b.major), cmp.Compare(a.minor, b.minor))
+	})
+	tree.Put(version{1, 10}, "b")
+	tree.Put(version{1, 2}, "a")
+	tree.Put(version{2, 0}, "c")
+
+	require.Equal(t, []string{"a", "b", "c"}, slices.Collect(tree.Values()))
+
+	key, value, ok := tree.Floor(version{1, 99})
+	require.Equal(t, true, ok)
+	require.Equal(t, version{1, 10}, key)
+	require.Equal(t, "b", value)
+}
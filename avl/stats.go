@@ -0,0 +1,79 @@
+package avl
+
+import (
+	"github.com/kirillrogovoy/computer-science/bounds"
+)
+
+var ErrIndexOutOfRange = bounds.ErrIndexOutOfRange
+
+type IndexError = bounds.IndexError
+
+// Select returns the k-th smallest key and its value, counting from 0.
+// Like with arrays, a negative k counts from the greatest key
+func Select[K, V any](t *Tree[K, V], k int) (K, V) {
+	key, value, err := TrySelect(t, k)
+	if err != nil {
+		panic(err)
+	}
+
+	return key, value
+}
+
+func TrySelect[K, V any](t *Tree[K, V], k int) (K, V, error) {
+	// allow negative index, means "from the end"
+	k, err := bounds.Normalize(k, Len(t))
+	if err != nil {
+		var key K
+		var value V
+		return key, value, err
+	}
+
+	n := t.root
+	for {
+		// the left subtree has the keys which come before n
+		left := size(n.left)
+		switch {
+		case k < left:
+			n = n.left
+		case k > left:
+			k -= left + 1
+			n = n.right
+		default:
+			return n.key, n.value, nil
+		}
+	}
+}
+
+// Rank returns the number of keys less than key, which is the index of key if it's in the tree
+func Rank[K, V any](t *Tree[K, V], key K) int {
+	rank := 0
+	for n := t.root; n != nil; {
+		c := t.compare(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			// n and everything on its left are less than key
+			rank += size(n.left) + 1
+			n = n.right
+		default:
+			return rank + size(n.left)
+		}
+	}
+
+	return rank
+}
+
+// CountRange returns the number of keys between lo and hi, both inclusive
+func CountRange[K, V any](t *Tree[K, V], lo, hi K) int {
+	if t.compare(lo, hi) > 0 {
+		return 0
+	}
+
+	count := Rank(t, hi) - Rank(t, lo)
+	if Contains(t, hi) {
+		count++
+	}
+
+	return count
+}
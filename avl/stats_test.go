@@ -0,0 +1,127 @@
+package avl
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/kirillrogovoy/computer-science/arrayGeneric"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelect(t *testing.T) {
+	tree := New[int, string]()
+	for _, key := range []int{50, 10, 40, 20, 30} {
+		tree.Put(key, fmt.Sprint(key))
+	}
+
+	for k, expected := range []int{10, 20, 30, 40, 50} {
+		key, value := tree.Select(k)
+		require.Equal(t, expected, key)
+		require.Equal(t, fmt.Sprint(expected), value)
+	}
+
+	key, _ := Select(tree, -1)
+	require.Equal(t, 50, key)
+	key, _ = Select(tree, -5)
+	require.Equal(t, 10, key)
+
+	_, _, err := tree.TrySelect(5)
+	require.Equal(t, &IndexError{Index: 5, Size: 5}, err)
+	_, _, err = tree.TrySelect(-6)
+	require.ErrorIs(t, err, ErrIndexOutOfRange)
+
+	require.Panics(t, func() { Select(New[int, int](), 0) })
+}
+
+func TestRank(t *testing.T) {
+	tree := New[int, int]()
+	for _, key := range []int{50, 10, 40, 20, 30} {
+		tree.Put(key, key)
+	}
+
+	require.Equal(t, 0, tree.Rank(5))
+	require.Equal(t, 0, tree.Rank(10))
+	require.Equal(t, 1, tree.Rank(11))
+	require.Equal(t, 2, tree.Rank(30))
+	require.Equal(t, 5, tree.Rank(99))
+
+	require.Equal(t, 5, tree.CountRange(10, 50))
+	require.Equal(t, 3, tree.CountRange(15, 45))
+	require.Equal(t, 1, tree.CountRange(30, 30))
+	require.Equal(t, 0, tree.CountRange(31, 39))
+	require.Equal(t, 0, tree.CountRange(50, 10))
+}
+
+func TestOrderStatisticsRandom(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+	tree := New[int, int]()
+	sorted := []int{}
+
+	for i := 0; i < 3000; i++ {
+		key := random.Intn(1000)
+		index, exists := slices.BinarySearch(sorted, key)
+
+		if random.Intn(3) > 0 {
+			if !exists {
+				sorted = slices.Insert(sorted, index, key)
+			}
+			tree.Put(key, key)
+		} else {
+			if exists {
+				sorted = slices.Delete(sorted, index, index+1)
+			}
+			tree.Delete(key)
+		}
+
+		require.NoError(t, tree.Validate())
+		require.Equal(t, index, tree.Rank(key))
+
+		if len(sorted) > 0 {
+			k := random.Intn(len(sorted))
+			selected, _ := tree.Select(k)
+			require.Equal(t, sorted[k], selected)
+		}
+
+		lo, hi := random.Intn(1000), random.Intn(1000)
+		expected := 0
+		if lo <= hi {
+			from, _ := slices.BinarySearch(sorted, lo)
+			to, found := slices.BinarySearch(sorted, hi)
+			if found {
+				to++
+			}
+			expected = to - from
+		}
+		require.Equal(t, expected, tree.CountRange(lo, hi))
+	}
+}
+
+// BenchmarkInsertSelect puts random keys asking for the median after each one.
+// A sorted array shifts half of its items on every insert, the tree only walks down
+func BenchmarkInsertSelect(b *testing.B) {
+	for _, size := range []int{1000, 100000} {
+		keys := rand.New(rand.NewSource(1)).Perm(size)
+
+		b.Run(fmt.Sprintf("avl/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				tree := New[int, int]()
+				for _, key := range keys {
+					tree.Put(key, key)
+					tree.Select(tree.Len() / 2)
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("sorted-array/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				arr := arrayGeneric.Create[int](0)
+				for _, key := range keys {
+					arrayGeneric.InsertSorted(arr, key)
+					arrayGeneric.At(arr, arrayGeneric.Size(arr)/2)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package bounds
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors shared by array, arrayAny, arrayGeneric and list so that callers
+// can check them with errors.Is regardless of the container
+var (
+	ErrIndexOutOfRange        = errors.New("index out of range")
+	ErrEmpty                  = errors.New("container is empty")
+	ErrConcurrentModification = errors.New("container was structurally modified during iteration")
+)
+
+// IndexError carries the offending index and the size of the container.
+// errors.Is(err, ErrIndexOutOfRange) holds for every IndexError
+type IndexError struct {
+	Index int
+	Size  int
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf(
+		"Index out of bound. The size of the container was %d, but the requested index was %d",
+		e.Size,
+		e.Index,
+	)
+}
+
+func (e *IndexError) Unwrap() error {
+	return ErrIndexOutOfRange
+}
+
+// Normalize turns a Python-style index into an offset in [0, size).
+// Negative indices count from the end, so -1 is the last element
+// and -size is the first one
+func Normalize(index int, size int) (int, error) {
+	if index < -size || index >= size {
+		return 0, &IndexError{index, size}
+	}
+
+	if index < 0 {
+		index += size
+	}
+
+	return index, nil
+}
+
+// NormalizeInsert is Normalize for insertion points, which also allow index == size
+// (append). Like Python's list.insert, -1 means "before the last element"
+func NormalizeInsert(index int, size int) (int, error) {
+	if index == size {
+		return index, nil
+	}
+
+	return Normalize(index, size)
+}
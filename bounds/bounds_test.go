@@ -0,0 +1,74 @@
+package bounds
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestIndexError(t *testing.T) {
+	var err error = &IndexError{5, 3}
+
+	require.Equal(t, true, errors.Is(err, ErrIndexOutOfRange))
+	require.Equal(t, false, errors.Is(err, ErrEmpty))
+	require.Equal(
+		t,
+		"Index out of bound. The size of the container was 3, but the requested index was 5",
+		err.Error(),
+	)
+
+	var indexErr *IndexError
+	require.Equal(t, true, errors.As(err, &indexErr))
+	require.Equal(t, 5, indexErr.Index)
+	require.Equal(t, 3, indexErr.Size)
+}
+
+func TestNormalize(t *testing.T) {
+	// keys are the requested indices and values are the expected offsets for size 3
+	testMap := map[int]int{
+		0:  0,
+		2:  2,
+		-1: 2,
+		-3: 0,
+	}
+
+	for index, expected := range testMap {
+		actual, err := Normalize(index, 3)
+		require.Nil(t, err)
+		require.Equal(t, expected, actual, "index %d", index)
+	}
+
+	for _, index := range []int{3, 4, -4, -10} {
+		_, err := Normalize(index, 3)
+		require.Equal(t, &IndexError{index, 3}, err)
+	}
+
+	_, err := Normalize(0, 0)
+	require.Equal(t, &IndexError{0, 0}, err)
+	_, err = Normalize(-1, 0)
+	require.Equal(t, &IndexError{-1, 0}, err)
+}
+
+func TestNormalizeInsert(t *testing.T) {
+	testMap := map[int]int{
+		0:  0,
+		3:  3,
+		-1: 2,
+		-3: 0,
+	}
+
+	for index, expected := range testMap {
+		actual, err := NormalizeInsert(index, 3)
+		require.Nil(t, err)
+		require.Equal(t, expected, actual, "index %d", index)
+	}
+
+	for _, index := range []int{4, -4} {
+		_, err := NormalizeInsert(index, 3)
+		require.Equal(t, &IndexError{index, 3}, err)
+	}
+
+	actual, err := NormalizeInsert(0, 0)
+	require.Nil(t, err)
+	require.Equal(t, 0, actual)
+}
@@ -0,0 +1,551 @@
+// Package bplustree is an ordered map on a B+tree: a B-tree which keeps all the
+// values in its leaves and only copies of keys, called separators, in its inner
+// nodes. The leaves are linked in the order of keys, so once a scan finds its
+// first leaf, it goes from leaf to leaf without climbing back up the tree.
+// With the minimum degree d, every node but the root has between d-1 and 2d-1 keys
+package bplustree
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/kirillrogovoy/computer-science/bounds"
+	"github.com/kirillrogovoy/computer-science/ordered"
+)
+
+var ErrConcurrentModification = bounds.ErrConcurrentModification
+
+// DefaultDegree fits the keys of a node of ints into a few cache lines
+const DefaultDegree = 16
+
+type node[K, V any] struct {
+	// keys are separators in inner nodes: the keys of children[i] are at least keys[i-1] and less than keys[i]
+	keys []K
+	// values are only kept in leaves
+	values   []V
+	children []*node[K, V]
+	// next is the leaf with the following keys, nil for the last one and for inner nodes
+	next *node[K, V]
+}
+
+func (n *node[K, V]) leaf() bool {
+	return len(n.children) == 0
+}
+
+type Tree[K, V any] struct {
+	// root is an empty leaf when the tree is empty
+	root    *node[K, V]
+	degree  int
+	size    int
+	compare func(a, b K) int
+	// mods counts structural modifications so that iterators can fail fast
+	mods int
+}
+
+var _ ordered.OrderedMap[int, int] = (*Tree[int, int])(nil)
+var _ ordered.Validator = (*Tree[int, int])(nil)
+
+// New returns a tree of keys which can be compared with <, like ints,
+// with the minimum degree degree. It panics if degree is less than 2
+func New[K cmp.Ordered, V any](degree int) *Tree[K, V] {
+	return NewFunc[K, V](degree, cmp.Compare[K])
+}
+
+// NewFunc returns a tree ordering keys with compare, so they can be of any type
+func NewFunc[K, V any](degree int, compare func(a, b K) int) *Tree[K, V] {
+	if degree < 2 {
+		panic(fmt.Sprintf("The minimum degree of a B+tree must be at least 2, got %d", degree))
+	}
+
+	return &Tree[K, V]{&node[K, V]{}, degree, 0, compare, 0}
+}
+
+func Len[K, V any](t *Tree[K, V]) int {
+	return t.size
+}
+
+func Empty[K, V any](t *Tree[K, V]) bool {
+	return t.size == 0
+}
+
+func Degree[K, V any](t *Tree[K, V]) int {
+	return t.degree
+}
+
+// Height returns the number of levels, 1 for a tree with just the root
+func Height[K, V any](t *Tree[K, V]) int {
+	height := 1
+	for n := t.root; !n.leaf(); n = n.children[0] {
+		height++
+	}
+
+	return height
+}
+
+// search returns the index of the first key in n which is not less than key and whether it's equal
+func search[K, V any](t *Tree[K, V], n *node[K, V], key K) (int, bool) {
+	return slices.BinarySearchFunc(n.keys, key, t.compare)
+}
+
+// route returns the index of the child of the inner node n whose keys may include key
+func route[K, V any](t *Tree[K, V], n *node[K, V], key K) int {
+	// a key equal to a separator lives to the right of it
+	i, found := search(t, n, key)
+	if found {
+		i++
+	}
+
+	return i
+}
+
+// findLeaf returns the leaf whose keys may include key
+func findLeaf[K, V any](t *Tree[K, V], key K) *node[K, V] {
+	n := t.root
+	for !n.leaf() {
+		n = n.children[route(t, n, key)]
+	}
+
+	return n
+}
+
+func full[K, V any](t *Tree[K, V], n *node[K, V]) bool {
+	return len(n.keys) == 2*t.degree-1
+}
+
+func Get[K, V any](t *Tree[K, V], key K) (V, bool) {
+	n := findLeaf(t, key)
+	i, found := search(t, n, key)
+	if !found {
+		var zero V
+		return zero, false
+	}
+
+	return n.values[i], true
+}
+
+func Contains[K, V any](t *Tree[K, V], key K) bool {
+	_, found := search(t, findLeaf(t, key), key)
+	return found
+}
+
+// Put sets the value of key and tells if the key is new.
+// Full nodes are split on the way down, so there's always room for a key going up
+func Put[K, V any](t *Tree[K, V], key K, value V) bool {
+	// updating a value doesn't change the structure, so it's done without any splits
+	leaf := findLeaf(t, key)
+	if i, found := search(t, leaf, key); found {
+		leaf.values[i] = value
+		return false
+	}
+
+	// the only way for the tree to grow higher: a new root above the split old one
+	if full(t, t.root) {
+		t.root = &node[K, V]{children: []*node[K, V]{t.root}}
+		split(t, t.root, 0)
+	}
+
+	n := t.root
+	for !n.leaf() {
+		i := route(t, n, key)
+		if full(t, n.children[i]) {
+			split(t, n, i)
+
+			// the new separator is keys[i], and the keys equal to it went right
+			if t.compare(key, n.keys[i]) >= 0 {
+				i++
+			}
+		}
+
+		n = n.children[i]
+	}
+
+	i, _ := search(t, n, key)
+	n.keys = slices.Insert(n.keys, i, key)
+	n.values = slices.Insert(n.values, i, value)
+
+	t.size++
+	t.mods++
+	return true
+}
+
+// split divides the full child i of n in two. A leaf keeps all of its keys
+// and copies the first key of the right half up to n, while an inner node
+// moves its median up as a B-tree node does
+func split[K, V any](t *Tree[K, V], n *node[K, V], i int) {
+	child := n.children[i]
+	median := t.degree - 1
+
+	var right *node[K, V]
+	if child.leaf() {
+		right = &node[K, V]{
+			keys:   slices.Clone(child.keys[median:]),
+			values: slices.Clone(child.values[median:]),
+			next:   child.next,
+		}
+		child.next = right
+		child.values = truncate(child.values, median)
+	} else {
+		right = &node[K, V]{
+			keys:     slices.Clone(child.keys[median+1:]),
+			children: slices.Clone(child.children[median+1:]),
+		}
+		child.children = truncate(child.children, median+1)
+	}
+
+	n.keys = slices.Insert(n.keys, i, child.keys[median])
+	n.children = slices.Insert(n.children, i+1, right)
+	child.keys = truncate(child.keys, median)
+}
+
+// Delete removes key and tells if it was there. On the way down, every node
+// the walk enters is first given at least d keys by borrowing from a sibling
+// or merging with one, so that taking a key out of it never leaves it too small.
+// A separator equal to the removed key may stay in an inner node: it still
+// divides the keys around it correctly
+func Delete[K, V any](t *Tree[K, V], key K) bool {
+	// the way down changes the structure, so don't take it for nothing
+	if !Contains(t, key) {
+		return false
+	}
+
+	n := t.root
+	for !n.leaf() {
+		i := route(t, n, key)
+
+		if len(n.children[i].keys) == t.degree-1 {
+			switch {
+			case i > 0 && len(n.children[i-1].keys) >= t.degree:
+				borrowFromLeft(n, i)
+			case i < len(n.keys) && len(n.children[i+1].keys) >= t.degree:
+				borrowFromRight(n, i)
+			case i < len(n.keys):
+				merge(n, i)
+			default:
+				merge(n, i-1)
+				i--
+			}
+		}
+
+		n = n.children[i]
+	}
+
+	i, _ := search(t, n, key)
+	n.keys = slices.Delete(n.keys, i, i+1)
+	n.values = slices.Delete(n.values, i, i+1)
+
+	// the only way for the tree to get lower: the root lost its last separator to a merge
+	if len(t.root.keys) == 0 && !t.root.leaf() {
+		t.root = t.root.children[0]
+	}
+
+	t.size--
+	t.mods++
+	return true
+}
+
+// borrowFromLeft moves the last key of the left sibling of child i into it
+func borrowFromLeft[K, V any](n *node[K, V], i int) {
+	child, sibling := n.children[i], n.children[i-1]
+	last := len(sibling.keys) - 1
+
+	if child.leaf() {
+		// the moved key is the new smallest key of child, so it separates them now
+		child.keys = slices.Insert(child.keys, 0, sibling.keys[last])
+		child.values = slices.Insert(child.values, 0, sibling.values[last])
+		sibling.values = truncate(sibling.values, last)
+		n.keys[i-1] = child.keys[0]
+	} else {
+		// the separator goes down and the last key of the sibling goes up in its place
+		child.keys = slices.Insert(child.keys, 0, n.keys[i-1])
+		child.children = slices.Insert(child.children, 0, sibling.children[last+1])
+		sibling.children = truncate(sibling.children, last+1)
+		n.keys[i-1] = sibling.keys[last]
+	}
+
+	sibling.keys = truncate(sibling.keys, last)
+}
+
+// borrowFromRight moves the first key of the right sibling of child i into it
+func borrowFromRight[K, V any](n *node[K, V], i int) {
+	child, sibling := n.children[i], n.children[i+1]
+
+	if child.leaf() {
+		child.keys = append(child.keys, sibling.keys[0])
+		child.values = append(child.values, sibling.values[0])
+		sibling.values = slices.Delete(sibling.values, 0, 1)
+		sibling.keys = slices.Delete(sibling.keys, 0, 1)
+		n.keys[i] = sibling.keys[0]
+	} else {
+		child.keys = append(child.keys, n.keys[i])
+		child.children = append(child.children, sibling.children[0])
+		sibling.children = slices.Delete(sibling.children, 0, 1)
+		n.keys[i] = sibling.keys[0]
+		sibling.keys = slices.Delete(sibling.keys, 0, 1)
+	}
+}
+
+// merge joins child i+1 into child i and drops the separator between them.
+// Leaves don't need the separator since its key is already in the right one
+func merge[K, V any](n *node[K, V], i int) {
+	left, right := n.children[i], n.children[i+1]
+
+	if left.leaf() {
+		left.keys = append(left.keys, right.keys...)
+		left.values = append(left.values, right.values...)
+		left.next = right.next
+	} else {
+		left.keys = append(append(left.keys, n.keys[i]), right.keys...)
+		left.children = append(left.children, right.children...)
+	}
+
+	n.keys = slices.Delete(n.keys, i, i+1)
+	n.children = slices.Delete(n.children, i+1, i+2)
+}
+
+// truncate cuts s to length n, letting the garbage collector have what the rest referred to
+func truncate[T any](s []T, n int) []T {
+	clear(s[n:])
+	return s[:n]
+}
+
+func minLeaf[K, V any](n *node[K, V]) *node[K, V] {
+	for !n.leaf() {
+		n = n.children[0]
+	}
+
+	return n
+}
+
+func maxLeaf[K, V any](n *node[K, V]) *node[K, V] {
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+
+	return n
+}
+
+// Min returns the smallest key and its value
+func Min[K, V any](t *Tree[K, V]) (K, V, bool) {
+	if Empty(t) {
+		return none[K, V]()
+	}
+
+	n := minLeaf(t.root)
+	return n.keys[0], n.values[0], true
+}
+
+// Max returns the greatest key and its value
+func Max[K, V any](t *Tree[K, V]) (K, V, bool) {
+	if Empty(t) {
+		return none[K, V]()
+	}
+
+	n := maxLeaf(t.root)
+	last := len(n.keys) - 1
+	return n.keys[last], n.values[last], true
+}
+
+// Floor returns the greatest key which is less than or equal to key
+func Floor[K, V any](t *Tree[K, V], key K) (K, V, bool) {
+	// the leaves aren't linked backwards, so remember the closest subtree to the left of the way down
+	var before *node[K, V]
+
+	n := t.root
+	for !n.leaf() {
+		i := route(t, n, key)
+		if i > 0 {
+			before = n.children[i-1]
+		}
+
+		n = n.children[i]
+	}
+
+	i, found := search(t, n, key)
+	switch {
+	case found:
+		return n.keys[i], n.values[i], true
+	case i > 0:
+		return n.keys[i-1], n.values[i-1], true
+	case before != nil:
+		n = maxLeaf(before)
+		last := len(n.keys) - 1
+		return n.keys[last], n.values[last], true
+	default:
+		return none[K, V]()
+	}
+}
+
+// Ceiling returns the smallest key which is greater than or equal to key
+func Ceiling[K, V any](t *Tree[K, V], key K) (K, V, bool) {
+	n := findLeaf(t, key)
+	i, _ := search(t, n, key)
+
+	// every key in the leaf is less than key, so the answer is the first one of the next leaf
+	if i == len(n.keys) {
+		n, i = n.next, 0
+	}
+
+	if n == nil || i == len(n.keys) {
+		return none[K, V]()
+	}
+
+	return n.keys[i], n.values[i], true
+}
+
+// Validate checks that every node but the root has between d-1 and 2d-1 sorted keys,
+// that an inner node with k separators has k+1 children whose keys fall between them,
+// that all leaves are at the same depth and that they are linked in order
+func Validate[K, V any](t *Tree[K, V]) error {
+	leaves := []*node[K, V]{}
+	count, _, err := validate(t, t.root, nil, nil, &leaves)
+	if err != nil {
+		return err
+	}
+
+	for i, leaf := range leaves {
+		var next *node[K, V]
+		if i+1 < len(leaves) {
+			next = leaves[i+1]
+		}
+
+		if leaf.next != next {
+			return fmt.Errorf("the leaf %v isn't linked to the leaf after it", leaf.keys)
+		}
+	}
+
+	if count != t.size {
+		return fmt.Errorf("the tree has %d keys, but its size is %d", count, t.size)
+	}
+
+	return nil
+}
+
+// validate checks the subtree of n, whose keys must be at least lo and less than hi
+// if those aren't nil, and appends its leaves to leaves.
+// It returns the number of keys in its leaves and its height
+func validate[K, V any](t *Tree[K, V], n *node[K, V], lo, hi *K, leaves *[]*node[K, V]) (int, int, error) {
+	if n != t.root && (len(n.keys) < t.degree-1 || len(n.keys) > 2*t.degree-1) {
+		return 0, 0, fmt.Errorf("the node %v has %d keys, out of [%d, %d]", n.keys, len(n.keys), t.degree-1, 2*t.degree-1)
+	}
+
+	if len(n.keys) > 2*t.degree-1 {
+		return 0, 0, fmt.Errorf("the root %v has %d keys, more than %d", n.keys, len(n.keys), 2*t.degree-1)
+	}
+
+	for i, key := range n.keys {
+		if (i > 0 && t.compare(n.keys[i-1], key) >= 0) || (lo != nil && t.compare(key, *lo) < 0) || (hi != nil && t.compare(key, *hi) >= 0) {
+			return 0, 0, fmt.Errorf("the key %v is out of order", key)
+		}
+	}
+
+	if n.leaf() {
+		if len(n.values) != len(n.keys) {
+			return 0, 0, fmt.Errorf("the leaf %v has %d values", n.keys, len(n.values))
+		}
+
+		*leaves = append(*leaves, n)
+		return len(n.keys), 1, nil
+	}
+
+	if len(n.values) != 0 || n.next != nil {
+		return 0, 0, fmt.Errorf("the inner node %v has values or a next leaf", n.keys)
+	}
+
+	if len(n.children) != len(n.keys)+1 {
+		return 0, 0, fmt.Errorf("the node %v has %d children", n.keys, len(n.children))
+	}
+
+	count := 0
+	height := 0
+	for i, child := range n.children {
+		childLo, childHi := lo, hi
+		if i > 0 {
+			childLo = &n.keys[i-1]
+		}
+		if i < len(n.keys) {
+			childHi = &n.keys[i]
+		}
+
+		childCount, childHeight, err := validate(t, child, childLo, childHi, leaves)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if i > 0 && childHeight != height {
+			return 0, 0, fmt.Errorf("the children of %v have the heights %d and %d", n.keys, height, childHeight)
+		}
+
+		count += childCount
+		height = childHeight
+	}
+
+	return count, height + 1, nil
+}
+
+// All yields key-value pairs in the order of keys, following the links between leaves.
+// It fails fast as ordered.OrderedMap describes
+func All[K, V any](t *Tree[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		scan(t, minLeaf(t.root), 0, nil, t.mods, yield)
+	}
+}
+
+// Range yields key-value pairs with keys between lo and hi, both inclusive, in the order of keys.
+// It goes down to the leaf of lo once and then along the leaves, so it's O(log n + k) for k keys in it
+func Range[K, V any](t *Tree[K, V], lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		n := findLeaf(t, lo)
+		i, _ := search(t, n, lo)
+		scan(t, n, i, &hi, t.mods, yield)
+	}
+}
+
+func Keys[K, V any](t *Tree[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range All(t) {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+func Values[K, V any](t *Tree[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range All(t) {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// scan yields the keys from the key i of the leaf n onwards until one is greater than hi if it isn't nil
+func scan[K, V any](t *Tree[K, V], n *node[K, V], i int, hi *K, mods int, yield func(K, V) bool) {
+	for ; n != nil; n, i = n.next, 0 {
+		for ; i < len(n.keys); i++ {
+			if hi != nil && t.compare(n.keys[i], *hi) > 0 {
+				return
+			}
+
+			if !yield(n.keys[i], n.values[i]) {
+				return
+			}
+			checkMods(t, mods)
+		}
+	}
+}
+
+func checkMods[K, V any](t *Tree[K, V], mods int) {
+	if t.mods != mods {
+		panic(ErrConcurrentModification)
+	}
+}
+
+func none[K, V any]() (K, V, bool) {
+	var key K
+	var value V
+	return key, value, false
+}
@@ -0,0 +1,187 @@
+package bplustree
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/kirillrogovoy/computer-science/ordered"
+	"github.com/kirillrogovoy/computer-science/ordered/orderedtest"
+	"github.com/stretchr/testify/require"
+)
+
+var degrees = []int{2, 3, 16}
+
+func TestConformance(t *testing.T) {
+	for _, degree := range degrees {
+		t.Run(fmt.Sprint(degree), func(t *testing.T) {
+			orderedtest.Run(t, func() ordered.OrderedMap[int, int] {
+				return New[int, int](degree)
+			})
+		})
+	}
+}
+
+func TestDegree(t *testing.T) {
+	require.Panics(t, func() { New[int, int](1) })
+	require.Equal(t, 3, New[int, int](3).Degree())
+}
+
+func TestSplit(t *testing.T) {
+	tree := New[int, int](2)
+	for key := 1; key <= 3; key++ {
+		tree.Put(key, key)
+	}
+	require.Equal(t, 1, tree.Height())
+
+	// the full leaf splits and a copy of the first key on the right goes up
+	tree.Put(4, 4)
+	require.Equal(t, 2, tree.Height())
+	require.Equal(t, []int{2}, tree.root.keys)
+	left, right := tree.root.children[0], tree.root.children[1]
+	require.Equal(t, []int{1}, left.keys)
+	require.Equal(t, []int{2, 3, 4}, right.keys)
+	require.Same(t, right, left.next)
+	require.NoError(t, tree.Validate())
+}
+
+func TestBorrowAndMerge(t *testing.T) {
+	//           [2 3 4]
+	//   [1] → [2] → [3] → [4 5 6]
+	tree := New[int, int](2)
+	for _, key := range []int{1, 2, 3, 4, 5, 6} {
+		tree.Put(key, key)
+	}
+	require.Equal(t, []int{2, 3, 4}, tree.root.keys)
+
+	// [3] is minimal, so it borrows 4 from its right sibling, whose new first key becomes the separator.
+	// The separator 3 stays, though there's no such key anymore
+	tree.Delete(3)
+	require.Equal(t, []int{2, 3, 5}, tree.root.keys)
+	require.Equal(t, []int{4}, tree.root.children[2].keys)
+	require.NoError(t, tree.Validate())
+
+	// [2] is minimal, so it borrows 1 from its left sibling, which becomes the separator
+	tree.Put(0, 0)
+	tree.Delete(2)
+	require.Equal(t, []int{1, 3, 5}, tree.root.keys)
+	require.Equal(t, []int{1}, tree.root.children[1].keys)
+	require.NoError(t, tree.Validate())
+
+	// [0] and [1] are both minimal, so they merge and the leaf chain skips the dropped one
+	tree.Delete(0)
+	require.Equal(t, []int{3, 5}, tree.root.keys)
+	require.Equal(t, []int{1}, tree.root.children[0].keys)
+	require.Same(t, tree.root.children[1], tree.root.children[0].next)
+	require.NoError(t, tree.Validate())
+
+	// the root loses its last separator and the tree gets lower
+	tree.Delete(5)
+	tree.Delete(6)
+	tree.Delete(4)
+	require.Equal(t, 1, tree.Height())
+	require.Equal(t, []int{1}, tree.root.keys)
+	require.NoError(t, tree.Validate())
+}
+
+func TestStaleSeparator(t *testing.T) {
+	tree := New[int, int](3)
+	for key := range 20 {
+		tree.Put(key, key)
+	}
+
+	// deleting a key doesn't have to remove its copies in the inner nodes
+	separator := tree.root.keys[0]
+	tree.Delete(separator)
+	require.NoError(t, tree.Validate())
+	require.False(t, tree.Contains(separator))
+
+	key, _, ok := tree.Floor(separator)
+	require.True(t, ok)
+	require.Equal(t, separator-1, key)
+
+	key, _, ok = tree.Ceiling(separator)
+	require.True(t, ok)
+	require.Equal(t, separator+1, key)
+
+	require.True(t, tree.Put(separator, 0))
+	require.NoError(t, tree.Validate())
+}
+
+func TestRange(t *testing.T) {
+	for _, degree := range degrees {
+		tree := New[int, string](degree)
+		for key := 0; key < 1000; key += 3 {
+			tree.Put(key, fmt.Sprint(key))
+		}
+
+		random := rand.New(rand.NewSource(int64(degree)))
+		for i := 0; i < 100; i++ {
+			lo, hi := random.Intn(1100)-50, random.Intn(1100)-50
+
+			expected := []int{}
+			for key := 0; key < 1000; key += 3 {
+				if key >= lo && key <= hi {
+					expected = append(expected, key)
+				}
+			}
+
+			actual := []int{}
+			for key, value := range tree.Range(lo, hi) {
+				require.Equal(t, fmt.Sprint(key), value)
+				actual = append(actual, key)
+			}
+			require.Equal(t, expected, actual, "range [%d, %d]", lo, hi)
+		}
+	}
+}
+
+func TestRandomWithValidate(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	tree := New[int, int](2)
+	model := map[int]int{}
+
+	for i := 0; i < 5000; i++ {
+		key := random.Intn(300)
+		if random.Intn(2) == 0 {
+			_, exists := model[key]
+			require.Equal(t, !exists, tree.Put(key, i))
+			model[key] = i
+		} else {
+			_, exists := model[key]
+			require.Equal(t, exists, tree.Delete(key))
+			delete(model, key)
+		}
+
+		if i%50 == 0 {
+			require.NoError(t, tree.Validate())
+		}
+	}
+
+	require.NoError(t, tree.Validate())
+	require.Equal(t, len(model), tree.Len())
+	for key, value := range tree.All() {
+		require.Equal(t, model[key], value)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tree := New[int, int](2)
+	for key := range 10 {
+		tree.Put(key, key)
+	}
+	require.NoError(t, Validate(tree))
+
+	leaf := minLeaf(tree.root)
+	leaf.keys[0] = 100
+	require.EqualError(t, Validate(tree), "the key 100 is out of order")
+	leaf.keys[0] = 0
+
+	next := leaf.next
+	leaf.next = nil
+	require.EqualError(t, Validate(tree), "the leaf [0] isn't linked to the leaf after it")
+	leaf.next = next
+
+	tree.size++
+	require.EqualError(t, Validate(tree), "the tree has 10 keys, but its size is 11")
+}
@@ -0,0 +1,203 @@
+// Package bst is an ordered map on a plain binary search tree: every key in the
+// left subtree of a node is smaller than the node's key and every key in the
+// right one is greater. Operations are O(h), which is O(log n) for random input
+// but O(n) when the keys come in order, since nothing keeps the tree balanced
+package bst
+
+import (
+	"cmp"
+
+	"github.com/kirillrogovoy/computer-science/bounds"
+	"github.com/kirillrogovoy/computer-science/internal/bintree"
+)
+
+var ErrConcurrentModification = bounds.ErrConcurrentModification
+
+type node[K, V any] struct {
+	key   K
+	value V
+	left  *node[K, V]
+	right *node[K, V]
+}
+
+// The accessors let the walks shared by the trees in internal/bintree read nodes
+
+func (n *node[K, V]) Key() K {
+	return n.key
+}
+
+func (n *node[K, V]) Value() V {
+	return n.value
+}
+
+func (n *node[K, V]) Left() *node[K, V] {
+	return n.left
+}
+
+func (n *node[K, V]) Right() *node[K, V] {
+	return n.right
+}
+
+type Tree[K, V any] struct {
+	root    *node[K, V]
+	size    int
+	compare func(a, b K) int
+	// mods counts structural modifications so that iterators can fail fast
+	mods int
+}
+
+// New returns a tree of keys which can be compared with <, like ints
+func New[K cmp.Ordered, V any]() *Tree[K, V] {
+	return NewFunc[K, V](cmp.Compare[K])
+}
+
+// NewFunc returns a tree ordering keys with compare, so they can be of any type
+func NewFunc[K, V any](compare func(a, b K) int) *Tree[K, V] {
+	return &Tree[K, V]{nil, 0, compare, 0}
+}
+
+func Len[K, V any](t *Tree[K, V]) int {
+	return t.size
+}
+
+func Empty[K, V any](t *Tree[K, V]) bool {
+	return t.size == 0
+}
+
+// find returns the link pointing at the node with key,
+// or the nil link where such a node would be attached
+func find[K, V any](t *Tree[K, V], key K) **node[K, V] {
+	link := &t.root
+	for *link != nil {
+		c := t.compare(key, (*link).key)
+		switch {
+		case c < 0:
+			link = &(*link).left
+		case c > 0:
+			link = &(*link).right
+		default:
+			return link
+		}
+	}
+
+	return link
+}
+
+func Get[K, V any](t *Tree[K, V], key K) (V, bool) {
+	n := *find(t, key)
+	if n == nil {
+		var zero V
+		return zero, false
+	}
+
+	return n.value, true
+}
+
+func Contains[K, V any](t *Tree[K, V], key K) bool {
+	return *find(t, key) != nil
+}
+
+// Put sets the value of key and tells if the key is new
+func Put[K, V any](t *Tree[K, V], key K, value V) bool {
+	link := find(t, key)
+	if *link != nil {
+		(*link).value = value
+		return false
+	}
+
+	*link = &node[K, V]{key: key, value: value}
+	t.size++
+	t.mods++
+	return true
+}
+
+// Delete removes key and tells if it was there
+func Delete[K, V any](t *Tree[K, V], key K) bool {
+	link := find(t, key)
+	n := *link
+	if n == nil {
+		return false
+	}
+
+	switch {
+	case n.left == nil:
+		*link = n.right
+	case n.right == nil:
+		*link = n.left
+	default:
+		// Hibbard deletion: the successor, the leftmost node of the right subtree,
+		// takes the place of n. It has no left child, so it's easy to unlink
+		successorLink := &n.right
+		for (*successorLink).left != nil {
+			successorLink = &(*successorLink).left
+		}
+
+		successor := *successorLink
+		*successorLink = successor.right
+
+		successor.left = n.left
+		successor.right = n.right
+		*link = successor
+	}
+
+	t.size--
+	t.mods++
+	return true
+}
+
+// Min returns the smallest key and its value
+func Min[K, V any](t *Tree[K, V]) (K, V, bool) {
+	return bintree.Min[K, V](t.root)
+}
+
+// Max returns the greatest key and its value
+func Max[K, V any](t *Tree[K, V]) (K, V, bool) {
+	return bintree.Max[K, V](t.root)
+}
+
+// Floor returns the greatest key which is less than or equal to key
+func Floor[K, V any](t *Tree[K, V], key K) (K, V, bool) {
+	return bintree.Floor[K, V](t.root, key, t.compare)
+}
+
+// Ceiling returns the smallest key which is greater than or equal to key
+func Ceiling[K, V any](t *Tree[K, V], key K) (K, V, bool) {
+	return bintree.Ceiling[K, V](t.root, key, t.compare)
+}
+
+// Height returns the number of nodes on the longest path from the root to a leaf,
+// 0 for an empty tree
+func Height[K, V any](t *Tree[K, V]) int {
+	return height(t.root)
+}
+
+func height[K, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+
+	return 1 + max(height(n.left), height(n.right))
+}
+
+// IsBST checks the ordering of the whole tree, not just of every parent and its children.
+// It's always true unless the keys were mutated in place or compare is inconsistent
+func IsBST[K, V any](t *Tree[K, V]) bool {
+	return isBST(t, t.root, nil, nil)
+}
+
+// isBST tells if every key under n is strictly between the keys of min and max, if those aren't nil
+func isBST[K, V any](t *Tree[K, V], n, min, max *node[K, V]) bool {
+	if n == nil {
+		return true
+	}
+
+	if min != nil && t.compare(n.key, min.key) <= 0 {
+		return false
+	}
+
+	if max != nil && t.compare(n.key, max.key) >= 0 {
+		return false
+	}
+
+	return isBST(t, n.left, min, n) && isBST(t, n.right, n, max)
+}
@@ -0,0 +1,191 @@
+package bst
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/kirillrogovoy/computer-science/ordered"
+	"github.com/kirillrogovoy/computer-science/ordered/orderedtest"
+	"github.com/stretchr/testify/require"
+)
+
+func fromKeys(keys ...int) *Tree[int, int] {
+	t := New[int, int]()
+	for _, key := range keys {
+		Put(t, key, key*10)
+	}
+	return t
+}
+
+// the unbalanced tree passes the same suite as the balanced ones, only slower
+func TestConformance(t *testing.T) {
+	orderedtest.Run(t, func() ordered.OrderedMap[int, int] {
+		return New[int, int]()
+	})
+}
+
+func TestPutGet(t *testing.T) {
+	tree := New[int, string]()
+	require.Equal(t, true, tree.Empty())
+
+	require.Equal(t, true, tree.Put(5, "five"))
+	require.Equal(t, true, tree.Put(3, "three"))
+	require.Equal(t, false, tree.Put(5, "FIVE"))
+	require.Equal(t, 2, tree.Len())
+
+	value, ok := tree.Get(5)
+	require.Equal(t, true, ok)
+	require.Equal(t, "FIVE", value)
+
+	_, ok = tree.Get(4)
+	require.Equal(t, false, ok)
+	require.Equal(t, true, tree.Contains(3))
+	require.Equal(t, false, tree.Contains(4))
+}
+
+func TestDelete(t *testing.T) {
+	//         5
+	//      3     8
+	//     1 4   7 9
+	//          6
+	tree := fromKeys(5, 3, 8, 1, 4, 7, 9, 6)
+
+	// a leaf
+	require.Equal(t, true, Delete(tree, 1))
+	// a node with one child
+	require.Equal(t, true, Delete(tree, 7))
+	// a node with two children whose successor has a right child
+	require.Equal(t, true, Delete(tree, 5))
+	// the root again, now the successor is the right child itself
+	require.Equal(t, true, Delete(tree, 6))
+
+	require.Equal(t, false, Delete(tree, 5))
+	require.Equal(t, 4, Len(tree))
+	require.Equal(t, true, IsBST(tree))
+	require.Equal(t, []int{3, 4, 8, 9}, slices.Collect(Keys(tree)))
+}
+
+func TestRandomOperations(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+	tree := New[int, int]()
+	model := map[int]int{}
+
+	for i := 0; i < 5000; i++ {
+		key := random.Intn(500)
+		_, exists := model[key]
+
+		if random.Intn(3) > 0 {
+			require.Equal(t, !exists, Put(tree, key, i))
+			model[key] = i
+		} else {
+			require.Equal(t, exists, Delete(tree, key))
+			delete(model, key)
+		}
+	}
+
+	require.Equal(t, len(model), Len(tree))
+	require.Equal(t, true, IsBST(tree))
+
+	keys := []int{}
+	for key, value := range All(tree) {
+		require.Equal(t, model[key], value)
+		keys = append(keys, key)
+	}
+	require.Equal(t, true, slices.IsSorted(keys))
+	require.Equal(t, len(model), len(keys))
+}
+
+func TestMinMax(t *testing.T) {
+	tree := New[int, int]()
+	_, _, ok := Min(tree)
+	require.Equal(t, false, ok)
+	_, _, ok = Max(tree)
+	require.Equal(t, false, ok)
+
+	tree = fromKeys(5, 3, 8, 1, 4, 7, 9)
+
+	key, value, ok := tree.Min()
+	require.Equal(t, true, ok)
+	require.Equal(t, 1, key)
+	require.Equal(t, 10, value)
+
+	key, _, _ = tree.Max()
+	require.Equal(t, 9, key)
+}
+
+func TestFloorCeiling(t *testing.T) {
+	tree := fromKeys(10, 20, 30, 40)
+
+	cases := []struct {
+		key            int
+		floor, ceiling int
+		hasFloor       bool
+		hasCeiling     bool
+	}{
+		{5, 0, 10, false, true},
+		{10, 10, 10, true, true},
+		{25, 20, 30, true, true},
+		{40, 40, 40, true, true},
+		{45, 40, 0, true, false},
+	}
+
+	for _, c := range cases {
+		floor, _, ok := tree.Floor(c.key)
+		require.Equal(t, c.hasFloor, ok, "floor of %d", c.key)
+		require.Equal(t, c.floor, floor, "floor of %d", c.key)
+
+		ceiling, _, ok := tree.Ceiling(c.key)
+		require.Equal(t, c.hasCeiling, ok, "ceiling of %d", c.key)
+		require.Equal(t, c.ceiling, ceiling, "ceiling of %d", c.key)
+	}
+}
+
+func TestHeight(t *testing.T) {
+	require.Equal(t, 0, Height(New[int, int]()))
+	require.Equal(t, 3, Height(fromKeys(5, 3, 8, 1, 4, 7, 9)))
+
+	// sorted input makes a linked list out of the tree
+	sorted := New[int, int]()
+	for key := 0; key < 100; key++ {
+		Put(sorted, key, key)
+	}
+	require.Equal(t, 100, sorted.Height())
+}
+
+func TestIsBST(t *testing.T) {
+	tree := fromKeys(5, 3, 8, 1, 4)
+	require.Equal(t, true, tree.IsBST())
+
+	// 6 is greater than its parent 3 but is in the left subtree of 5
+	tree.root.left.right.key = 6
+	require.Equal(t, false, tree.IsBST())
+}
+
+type point struct {
+	x, y int
+}
+
+func TestComparator(t *testing.T) {
+	// keys of any type, like arrayAny, ordered by a comparator
+	tree := NewFunc[any, string](func(a, b any) int {
+		return cmp.Compare(a.(int), b.(int))
+	})
+	tree.Put(2, "two")
+	tree.Put(1, "one")
+	require.Equal(t, []any{1, 2}, slices.Collect(tree.Keys()))
+
+	// keys which aren't ordered by <, sorted by y then x
+	points := NewFunc[point, bool](func(a, b point) int {
+		return cmp.Or(cmp.Compare(a.y, b.y), cmp.Compare(a.x, b.x))
+	})
+	points.Put(point{3, 1}, true)
+	points.Put(point{1, 2}, true)
+	points.Put(point{2, 1}, true)
+
+	require.Equal(t, []point{{2, 1}, {3, 1}, {1, 2}}, slices.Collect(points.Keys()))
+
+	key, _, _ := points.Ceiling(point{0, 2})
+	require.Equal(t, point{1, 2}, key)
+}
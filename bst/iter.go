@@ -0,0 +1,131 @@
+package bst
+
+import (
+	"iter"
+
+	"github.com/kirillrogovoy/computer-science/deque"
+	"github.com/kirillrogovoy/computer-science/internal/bintree"
+)
+
+// All yields key-value pairs in the order of keys. It's the same as InOrder
+func All[K, V any](t *Tree[K, V]) iter.Seq2[K, V] {
+	return InOrder(t)
+}
+
+func Keys[K, V any](t *Tree[K, V]) iter.Seq[K] {
+	return bintree.Keys(All(t))
+}
+
+func Values[K, V any](t *Tree[K, V]) iter.Seq[V] {
+	return bintree.Values(All(t))
+}
+
+// InOrder yields the left subtree, the node, then the right subtree, which is the order of keys.
+// Like the rest of the traversals, it fails fast as ordered.OrderedMap describes
+func InOrder[K, V any](t *Tree[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		mods := t.mods
+		bintree.InOrder[K, V](t.root, func(key K, value V) bool {
+			if !yield(key, value) {
+				return false
+			}
+			checkMods(t, mods)
+			return true
+		})
+	}
+}
+
+// PreOrder yields the node, then the left subtree, then the right one.
+// Putting the keys into an empty tree in this order rebuilds the same tree
+func PreOrder[K, V any](t *Tree[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if t.root == nil {
+			return
+		}
+
+		mods := t.mods
+		stack := deque.Create[*node[K, V]](0)
+		stack.PushBack(t.root)
+
+		for !stack.IsEmpty() {
+			n := stack.PopBack()
+			if !yield(n.key, n.value) {
+				return
+			}
+			checkMods(t, mods)
+
+			// the left subtree goes on top to come first
+			if n.right != nil {
+				stack.PushBack(n.right)
+			}
+			if n.left != nil {
+				stack.PushBack(n.left)
+			}
+		}
+	}
+}
+
+// PostOrder yields the left subtree, then the right one, then the node,
+// so children always come before their parents
+func PostOrder[K, V any](t *Tree[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		mods := t.mods
+		stack := deque.Create[*node[K, V]](0)
+
+		// prev is the last yielded node, which tells if the right subtree of the node on top is done
+		var prev *node[K, V]
+		for n := t.root; n != nil || !stack.IsEmpty(); {
+			for ; n != nil; n = n.left {
+				stack.PushBack(n)
+			}
+
+			top := stack.Back()
+			if top.right != nil && top.right != prev {
+				n = top.right
+				continue
+			}
+
+			stack.PopBack()
+			if !yield(top.key, top.value) {
+				return
+			}
+			checkMods(t, mods)
+
+			prev = top
+		}
+	}
+}
+
+// LevelOrder yields the nodes level by level from the root down, left to right within a level
+func LevelOrder[K, V any](t *Tree[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if t.root == nil {
+			return
+		}
+
+		mods := t.mods
+		queue := deque.Create[*node[K, V]](0)
+		queue.PushBack(t.root)
+
+		for !queue.IsEmpty() {
+			n := queue.PopFront()
+			if !yield(n.key, n.value) {
+				return
+			}
+			checkMods(t, mods)
+
+			if n.left != nil {
+				queue.PushBack(n.left)
+			}
+			if n.right != nil {
+				queue.PushBack(n.right)
+			}
+		}
+	}
+}
+
+func checkMods[K, V any](t *Tree[K, V], mods int) {
+	if t.mods != mods {
+		panic(ErrConcurrentModification)
+	}
+}
@@ -0,0 +1,79 @@
+package bst
+
+import (
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func collectKeys(seq iter.Seq2[int, int]) []int {
+	keys := []int{}
+	for key := range seq {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func TestTraversals(t *testing.T) {
+	//         5
+	//      3     8
+	//     1 4   7 9
+	tree := fromKeys(5, 3, 8, 1, 4, 7, 9)
+
+	require.Equal(t, []int{1, 3, 4, 5, 7, 8, 9}, collectKeys(tree.InOrder()))
+	require.Equal(t, []int{5, 3, 1, 4, 8, 7, 9}, collectKeys(tree.PreOrder()))
+	require.Equal(t, []int{1, 4, 3, 7, 9, 8, 5}, collectKeys(tree.PostOrder()))
+	require.Equal(t, []int{5, 3, 8, 1, 4, 7, 9}, collectKeys(tree.LevelOrder()))
+	require.Equal(t, []int{10, 30, 40, 50, 70, 80, 90}, slices.Collect(tree.Values()))
+}
+
+func TestTraversalsOfEmptyTree(t *testing.T) {
+	tree := New[int, int]()
+
+	for _, traversal := range []func(*Tree[int, int]) iter.Seq2[int, int]{InOrder, PreOrder, PostOrder, LevelOrder} {
+		require.Equal(t, []int{}, collectKeys(traversal(tree)))
+	}
+}
+
+func TestPreOrderRebuildsTree(t *testing.T) {
+	tree := fromKeys(50, 20, 80, 10, 30, 25, 90, 85)
+	rebuilt := fromKeys(collectKeys(tree.PreOrder())...)
+
+	require.Equal(t, collectKeys(tree.LevelOrder()), collectKeys(rebuilt.LevelOrder()))
+}
+
+func TestTraversalsStop(t *testing.T) {
+	tree := fromKeys(5, 3, 8, 1, 4, 7, 9)
+
+	for _, traversal := range []func(*Tree[int, int]) iter.Seq2[int, int]{InOrder, PreOrder, PostOrder, LevelOrder} {
+		count := 0
+		for range traversal(tree) {
+			count++
+			if count == 3 {
+				break
+			}
+		}
+		require.Equal(t, 3, count)
+	}
+}
+
+func TestConcurrentModification(t *testing.T) {
+	tree := fromKeys(5, 3, 8)
+
+	// updating values is fine
+	for key, value := range tree.All() {
+		tree.Put(key, value+1)
+	}
+	value, _ := tree.Get(3)
+	require.Equal(t, 31, value)
+
+	for _, traversal := range []func(*Tree[int, int]) iter.Seq2[int, int]{InOrder, PreOrder, PostOrder, LevelOrder} {
+		require.Panics(t, func() {
+			for key := range traversal(tree) {
+				tree.Put(key+100, 0)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package bst
+
+import (
+	"iter"
+)
+
+// Method forms of the package-level functions
+
+func (t *Tree[K, V]) Len() int {
+	return Len(t)
+}
+
+func (t *Tree[K, V]) Empty() bool {
+	return Empty(t)
+}
+
+func (t *Tree[K, V]) Get(key K) (V, bool) {
+	return Get(t, key)
+}
+
+func (t *Tree[K, V]) Contains(key K) bool {
+	return Contains(t, key)
+}
+
+func (t *Tree[K, V]) Put(key K, value V) bool {
+	return Put(t, key, value)
+}
+
+func (t *Tree[K, V]) Delete(key K) bool {
+	return Delete(t, key)
+}
+
+func (t *Tree[K, V]) Min() (K, V, bool) {
+	return Min(t)
+}
+
+func (t *Tree[K, V]) Max() (K, V, bool) {
+	return Max(t)
+}
+
+func (t *Tree[K, V]) Floor(key K) (K, V, bool) {
+	return Floor(t, key)
+}
+
+func (t *Tree[K, V]) Ceiling(key K) (K, V, bool) {
+	return Ceiling(t, key)
+}
+
+func (t *Tree[K, V]) Height() int {
+	return Height(t)
+}
+
+func (t *Tree[K, V]) IsBST() bool {
+	return IsBST(t)
+}
+
+func (t *Tree[K, V]) All() iter.Seq2[K, V] {
+	return All(t)
+}
+
+func (t *Tree[K, V]) Keys() iter.Seq[K] {
+	return Keys(t)
+}
+
+func (t *Tree[K, V]) Values() iter.Seq[V] {
+	return Values(t)
+}
+
+func (t *Tree[K, V]) InOrder() iter.Seq2[K, V] {
+	return InOrder(t)
+}
+
+func (t *Tree[K, V]) PreOrder() iter.Seq2[K, V] {
+	return PreOrder(t)
+}
+
+func (t *Tree[K, V]) PostOrder() iter.Seq2[K, V] {
+	return PostOrder(t)
+}
+
+func (t *Tree[K, V]) LevelOrder() iter.Seq2[K, V] {
+	return LevelOrder(t)
+}
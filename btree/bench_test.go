@@ -0,0 +1,160 @@
+package btree
+
+import (
+	"iter"
+	"math/rand"
+	"testing"
+
+	"github.com/kirillrogovoy/computer-science/arrayGeneric"
+	"github.com/kirillrogovoy/computer-science/avl"
+	"github.com/kirillrogovoy/computer-science/bplustree"
+	"github.com/kirillrogovoy/computer-science/bst"
+	"github.com/kirillrogovoy/computer-science/ordered"
+	"github.com/kirillrogovoy/computer-science/rbtree"
+)
+
+// The benchmarks below compare the B-trees with the binary trees, which
+// follow a pointer to a separately allocated node for every comparison,
+// and with a sorted array, which is a single contiguous block.
+// Once the keys don't fit in the cache, the cost is mostly in cache misses:
+// about one per level, and a B-tree has several times fewer levels
+
+const benchSize = 1000000
+
+type benchMap struct {
+	name string
+	new  func() ordered.OrderedMap[int, int]
+}
+
+var benchMaps = []benchMap{
+	{"btree/2", func() ordered.OrderedMap[int, int] { return New[int, int](2) }},
+	{"btree/16", func() ordered.OrderedMap[int, int] { return New[int, int](16) }},
+	{"btree/64", func() ordered.OrderedMap[int, int] { return New[int, int](64) }},
+	{"bplustree/16", func() ordered.OrderedMap[int, int] { return bplustree.New[int, int](16) }},
+	{"bplustree/64", func() ordered.OrderedMap[int, int] { return bplustree.New[int, int](64) }},
+	{"avl", func() ordered.OrderedMap[int, int] { return avl.New[int, int]() }},
+	{"rbtree", func() ordered.OrderedMap[int, int] { return rbtree.New[int, int]() }},
+	{"bst", func() ordered.OrderedMap[int, int] { return bst.New[int, int]() }},
+}
+
+func benchKeys() []int {
+	return rand.New(rand.NewSource(1)).Perm(benchSize)
+}
+
+func fill(m ordered.OrderedMap[int, int], keys []int) ordered.OrderedMap[int, int] {
+	for _, key := range keys {
+		m.Put(key, key)
+	}
+
+	return m
+}
+
+func sortedArray(size int) *arrayGeneric.Array[int] {
+	arr := arrayGeneric.Create[int](size)
+	for key := range size {
+		arrayGeneric.Push(arr, key)
+	}
+
+	return arr
+}
+
+func BenchmarkGet(b *testing.B) {
+	keys := benchKeys()
+
+	for _, bench := range benchMaps {
+		m := fill(bench.new(), keys)
+		b.Run(bench.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				m.Get(keys[i%len(keys)])
+			}
+		})
+	}
+
+	arr := sortedArray(benchSize)
+	b.Run("sorted-array", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			arrayGeneric.BinarySearch(arr, keys[i%len(keys)])
+		}
+	})
+}
+
+// BenchmarkPut builds a map of 100000 random keys. The sorted array is left out:
+// every insert shifts half of it, which is the quadratic cost the trees avoid
+func BenchmarkPut(b *testing.B) {
+	keys := benchKeys()[:100000]
+
+	for _, bench := range benchMaps {
+		b.Run(bench.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				fill(bench.new(), keys)
+			}
+		})
+	}
+}
+
+// BenchmarkScan sums every key in order, where the B-trees read whole nodes
+// and the binary trees jump to a new node for every key
+func BenchmarkScan(b *testing.B) {
+	keys := benchKeys()
+
+	for _, bench := range benchMaps {
+		m := fill(bench.new(), keys)
+		b.Run(bench.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sum := 0
+				for key := range m.Keys() {
+					sum += key
+				}
+			}
+		})
+	}
+
+	arr := sortedArray(benchSize)
+	b.Run("sorted-array", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sum := 0
+			for key := range arrayGeneric.Values(arr) {
+				sum += key
+			}
+		}
+	})
+}
+
+// ranger is implemented by the B-trees, which go down to the first key once
+// and then read keys in order from there
+type ranger interface {
+	Range(lo, hi int) iter.Seq2[int, int]
+}
+
+// BenchmarkRange reads 100 consecutive keys starting from a random one
+func BenchmarkRange(b *testing.B) {
+	keys := benchKeys()
+
+	for _, bench := range benchMaps {
+		m := fill(bench.new(), keys)
+		b.Run(bench.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				lo := keys[i%len(keys)]
+
+				if r, ok := m.(ranger); ok {
+					for range r.Range(lo, lo+99) {
+					}
+					continue
+				}
+
+				// the binary trees have no range iterator, so every next key is another walk from the root
+				for key, _, ok := m.Ceiling(lo); ok && key <= lo+99; key, _, ok = m.Ceiling(key + 1) {
+				}
+			}
+		})
+	}
+
+	arr := sortedArray(benchSize)
+	b.Run("sorted-array", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			lo := keys[i%len(keys)]
+			for j := arrayGeneric.LowerBound(arr, lo); j < arrayGeneric.Size(arr) && arrayGeneric.At(arr, j) <= lo+99; j++ {
+			}
+		}
+	})
+}
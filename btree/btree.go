@@ -0,0 +1,561 @@
+// Package btree is an ordered map on a B-tree: a balanced search tree whose nodes
+// hold many sorted keys in contiguous slices, each key with its value. With the
+// minimum degree d, every node but the root has between d-1 and 2d-1 keys and
+// an inner node with k keys has k+1 children. Wide nodes make the tree shallow,
+// so a lookup touches few nodes and does most of its comparisons within
+// a cache line or two instead of chasing a pointer per comparison
+package btree
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/kirillrogovoy/computer-science/bounds"
+	"github.com/kirillrogovoy/computer-science/ordered"
+)
+
+var ErrConcurrentModification = bounds.ErrConcurrentModification
+
+// DefaultDegree fits the keys of a node of ints into a few cache lines
+const DefaultDegree = 16
+
+type node[K, V any] struct {
+	keys   []K
+	values []V
+	// children is empty for leaves. The keys of children[i] are between keys[i-1] and keys[i]
+	children []*node[K, V]
+}
+
+func (n *node[K, V]) leaf() bool {
+	return len(n.children) == 0
+}
+
+type Tree[K, V any] struct {
+	// root is an empty leaf when the tree is empty
+	root    *node[K, V]
+	degree  int
+	size    int
+	compare func(a, b K) int
+	// mods counts structural modifications so that iterators can fail fast
+	mods int
+}
+
+var _ ordered.OrderedMap[int, int] = (*Tree[int, int])(nil)
+var _ ordered.Validator = (*Tree[int, int])(nil)
+
+// New returns a tree of keys which can be compared with <, like ints,
+// with the minimum degree degree. It panics if degree is less than 2
+func New[K cmp.Ordered, V any](degree int) *Tree[K, V] {
+	return NewFunc[K, V](degree, cmp.Compare[K])
+}
+
+// NewFunc returns a tree ordering keys with compare, so they can be of any type
+func NewFunc[K, V any](degree int, compare func(a, b K) int) *Tree[K, V] {
+	if degree < 2 {
+		panic(fmt.Sprintf("The minimum degree of a B-tree must be at least 2, got %d", degree))
+	}
+
+	return &Tree[K, V]{&node[K, V]{}, degree, 0, compare, 0}
+}
+
+func Len[K, V any](t *Tree[K, V]) int {
+	return t.size
+}
+
+func Empty[K, V any](t *Tree[K, V]) bool {
+	return t.size == 0
+}
+
+func Degree[K, V any](t *Tree[K, V]) int {
+	return t.degree
+}
+
+// Height returns the number of levels, 1 for a tree with just the root
+func Height[K, V any](t *Tree[K, V]) int {
+	height := 1
+	for n := t.root; !n.leaf(); n = n.children[0] {
+		height++
+	}
+
+	return height
+}
+
+// search returns the index of the first key in n which is not less than key and whether it's equal
+func search[K, V any](t *Tree[K, V], n *node[K, V], key K) (int, bool) {
+	return slices.BinarySearchFunc(n.keys, key, t.compare)
+}
+
+func full[K, V any](t *Tree[K, V], n *node[K, V]) bool {
+	return len(n.keys) == 2*t.degree-1
+}
+
+// find returns the node holding key and the index of key in it, or nil
+func find[K, V any](t *Tree[K, V], key K) (*node[K, V], int) {
+	n := t.root
+	for {
+		i, found := search(t, n, key)
+		if found {
+			return n, i
+		}
+
+		if n.leaf() {
+			return nil, 0
+		}
+
+		n = n.children[i]
+	}
+}
+
+func Get[K, V any](t *Tree[K, V], key K) (V, bool) {
+	n, i := find(t, key)
+	if n == nil {
+		var zero V
+		return zero, false
+	}
+
+	return n.values[i], true
+}
+
+func Contains[K, V any](t *Tree[K, V], key K) bool {
+	n, _ := find(t, key)
+	return n != nil
+}
+
+// Put sets the value of key and tells if the key is new.
+// Full nodes are split on the way down, so there's always room for a key going up
+func Put[K, V any](t *Tree[K, V], key K, value V) bool {
+	// updating a value doesn't change the structure, so it's done without any splits
+	if n, i := find(t, key); n != nil {
+		n.values[i] = value
+		return false
+	}
+
+	// the only way for the tree to grow higher: a new root above the split old one
+	if full(t, t.root) {
+		t.root = &node[K, V]{children: []*node[K, V]{t.root}}
+		split(t, t.root, 0)
+	}
+
+	n := t.root
+	for {
+		i, _ := search(t, n, key)
+
+		if n.leaf() {
+			n.keys = slices.Insert(n.keys, i, key)
+			n.values = slices.Insert(n.values, i, value)
+			break
+		}
+
+		if full(t, n.children[i]) {
+			split(t, n, i)
+
+			// the median of the child went up to keys[i]
+			if t.compare(key, n.keys[i]) > 0 {
+				i++
+			}
+		}
+
+		n = n.children[i]
+	}
+
+	t.size++
+	t.mods++
+	return true
+}
+
+// split divides the full child i of n in two around its median, which goes up to n
+func split[K, V any](t *Tree[K, V], n *node[K, V], i int) {
+	child := n.children[i]
+	median := t.degree - 1
+
+	right := &node[K, V]{
+		keys:   slices.Clone(child.keys[median+1:]),
+		values: slices.Clone(child.values[median+1:]),
+	}
+	if !child.leaf() {
+		right.children = slices.Clone(child.children[median+1:])
+	}
+
+	n.keys = slices.Insert(n.keys, i, child.keys[median])
+	n.values = slices.Insert(n.values, i, child.values[median])
+	n.children = slices.Insert(n.children, i+1, right)
+
+	child.keys = truncate(child.keys, median)
+	child.values = truncate(child.values, median)
+	if !child.leaf() {
+		child.children = truncate(child.children, median+1)
+	}
+}
+
+// Delete removes key and tells if it was there. On the way down, every node
+// the walk enters is first given at least d keys by borrowing from a sibling
+// or merging with one, so that taking a key out of it never leaves it too small
+func Delete[K, V any](t *Tree[K, V], key K) bool {
+	// the way down changes the structure, so don't take it for nothing
+	if !Contains(t, key) {
+		return false
+	}
+
+	remove(t, t.root, key)
+
+	// the only way for the tree to get lower: the root lost its last key to a merge
+	if len(t.root.keys) == 0 && !t.root.leaf() {
+		t.root = t.root.children[0]
+	}
+
+	t.size--
+	t.mods++
+	return true
+}
+
+// remove deletes key, which must be in the subtree of n
+func remove[K, V any](t *Tree[K, V], n *node[K, V], key K) {
+	i, found := search(t, n, key)
+
+	if n.leaf() {
+		n.keys = slices.Delete(n.keys, i, i+1)
+		n.values = slices.Delete(n.values, i, i+1)
+		return
+	}
+
+	if found {
+		left, right := n.children[i], n.children[i+1]
+		switch {
+		case len(left.keys) >= t.degree:
+			// the predecessor takes the place of key and is removed from the left subtree instead
+			predecessor := maxNode(left)
+			last := len(predecessor.keys) - 1
+			n.keys[i], n.values[i] = predecessor.keys[last], predecessor.values[last]
+			remove(t, left, n.keys[i])
+		case len(right.keys) >= t.degree:
+			successor := minNode(right)
+			n.keys[i], n.values[i] = successor.keys[0], successor.values[0]
+			remove(t, right, n.keys[i])
+		default:
+			// both are minimal, so key goes down into their merge
+			merge(t, n, i)
+			remove(t, left, key)
+		}
+
+		return
+	}
+
+	if len(n.children[i].keys) == t.degree-1 {
+		switch {
+		case i > 0 && len(n.children[i-1].keys) >= t.degree:
+			borrowFromLeft(t, n, i)
+		case i < len(n.keys) && len(n.children[i+1].keys) >= t.degree:
+			borrowFromRight(t, n, i)
+		case i < len(n.keys):
+			merge(t, n, i)
+		default:
+			merge(t, n, i-1)
+			i--
+		}
+	}
+
+	remove(t, n.children[i], key)
+}
+
+// borrowFromLeft moves the separator before child i down into it and the last key of its left sibling up
+func borrowFromLeft[K, V any](t *Tree[K, V], n *node[K, V], i int) {
+	child, sibling := n.children[i], n.children[i-1]
+	last := len(sibling.keys) - 1
+
+	child.keys = slices.Insert(child.keys, 0, n.keys[i-1])
+	child.values = slices.Insert(child.values, 0, n.values[i-1])
+	n.keys[i-1], n.values[i-1] = sibling.keys[last], sibling.values[last]
+	sibling.keys = truncate(sibling.keys, last)
+	sibling.values = truncate(sibling.values, last)
+
+	if !child.leaf() {
+		child.children = slices.Insert(child.children, 0, sibling.children[last+1])
+		sibling.children = truncate(sibling.children, last+1)
+	}
+}
+
+// borrowFromRight moves the separator after child i down into it and the first key of its right sibling up
+func borrowFromRight[K, V any](t *Tree[K, V], n *node[K, V], i int) {
+	child, sibling := n.children[i], n.children[i+1]
+
+	child.keys = append(child.keys, n.keys[i])
+	child.values = append(child.values, n.values[i])
+	n.keys[i], n.values[i] = sibling.keys[0], sibling.values[0]
+	sibling.keys = slices.Delete(sibling.keys, 0, 1)
+	sibling.values = slices.Delete(sibling.values, 0, 1)
+
+	if !child.leaf() {
+		child.children = append(child.children, sibling.children[0])
+		sibling.children = slices.Delete(sibling.children, 0, 1)
+	}
+}
+
+// merge joins child i, the separator after it and child i+1 into child i
+func merge[K, V any](t *Tree[K, V], n *node[K, V], i int) {
+	left, right := n.children[i], n.children[i+1]
+
+	left.keys = append(append(left.keys, n.keys[i]), right.keys...)
+	left.values = append(append(left.values, n.values[i]), right.values...)
+	left.children = append(left.children, right.children...)
+
+	n.keys = slices.Delete(n.keys, i, i+1)
+	n.values = slices.Delete(n.values, i, i+1)
+	n.children = slices.Delete(n.children, i+1, i+2)
+}
+
+// truncate cuts s to length n, letting the garbage collector have what the rest referred to
+func truncate[T any](s []T, n int) []T {
+	clear(s[n:])
+	return s[:n]
+}
+
+func minNode[K, V any](n *node[K, V]) *node[K, V] {
+	for !n.leaf() {
+		n = n.children[0]
+	}
+
+	return n
+}
+
+func maxNode[K, V any](n *node[K, V]) *node[K, V] {
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+
+	return n
+}
+
+// Min returns the smallest key and its value
+func Min[K, V any](t *Tree[K, V]) (K, V, bool) {
+	if Empty(t) {
+		return none[K, V]()
+	}
+
+	n := minNode(t.root)
+	return n.keys[0], n.values[0], true
+}
+
+// Max returns the greatest key and its value
+func Max[K, V any](t *Tree[K, V]) (K, V, bool) {
+	if Empty(t) {
+		return none[K, V]()
+	}
+
+	n := maxNode(t.root)
+	last := len(n.keys) - 1
+	return n.keys[last], n.values[last], true
+}
+
+// Floor returns the greatest key which is less than or equal to key
+func Floor[K, V any](t *Tree[K, V], key K) (K, V, bool) {
+	var floor *node[K, V]
+	floorIndex := 0
+
+	n := t.root
+	for {
+		i, found := search(t, n, key)
+		if found {
+			return n.keys[i], n.values[i], true
+		}
+
+		// the key before i fits, but there may be a greater one in the child between them
+		if i > 0 {
+			floor, floorIndex = n, i-1
+		}
+
+		if n.leaf() {
+			break
+		}
+
+		n = n.children[i]
+	}
+
+	if floor == nil {
+		return none[K, V]()
+	}
+
+	return floor.keys[floorIndex], floor.values[floorIndex], true
+}
+
+// Ceiling returns the smallest key which is greater than or equal to key
+func Ceiling[K, V any](t *Tree[K, V], key K) (K, V, bool) {
+	var ceiling *node[K, V]
+	ceilingIndex := 0
+
+	n := t.root
+	for {
+		i, found := search(t, n, key)
+		if found {
+			return n.keys[i], n.values[i], true
+		}
+
+		// the key at i fits, but there may be a smaller one in the child before it
+		if i < len(n.keys) {
+			ceiling, ceilingIndex = n, i
+		}
+
+		if n.leaf() {
+			break
+		}
+
+		n = n.children[i]
+	}
+
+	if ceiling == nil {
+		return none[K, V]()
+	}
+
+	return ceiling.keys[ceilingIndex], ceiling.values[ceilingIndex], true
+}
+
+// Validate checks that every node but the root has between d-1 and 2d-1 sorted keys,
+// that an inner node with k keys has k+1 children whose keys fall between its keys
+// and that all leaves are at the same depth
+func Validate[K, V any](t *Tree[K, V]) error {
+	count, _, err := validate(t, t.root, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if count != t.size {
+		return fmt.Errorf("the tree has %d keys, but its size is %d", count, t.size)
+	}
+
+	return nil
+}
+
+// validate checks the subtree of n, whose keys must be strictly between lo and hi
+// if those aren't nil. It returns the number of keys in it and its height
+func validate[K, V any](t *Tree[K, V], n *node[K, V], lo, hi *K) (int, int, error) {
+	if n != t.root && (len(n.keys) < t.degree-1 || len(n.keys) > 2*t.degree-1) {
+		return 0, 0, fmt.Errorf("the node %v has %d keys, out of [%d, %d]", n.keys, len(n.keys), t.degree-1, 2*t.degree-1)
+	}
+
+	if len(n.keys) > 2*t.degree-1 {
+		return 0, 0, fmt.Errorf("the root %v has %d keys, more than %d", n.keys, len(n.keys), 2*t.degree-1)
+	}
+
+	if len(n.values) != len(n.keys) {
+		return 0, 0, fmt.Errorf("the node %v has %d values", n.keys, len(n.values))
+	}
+
+	for i, key := range n.keys {
+		if (i > 0 && t.compare(n.keys[i-1], key) >= 0) || (lo != nil && t.compare(key, *lo) <= 0) || (hi != nil && t.compare(key, *hi) >= 0) {
+			return 0, 0, fmt.Errorf("the key %v is out of order", key)
+		}
+	}
+
+	if n.leaf() {
+		return len(n.keys), 1, nil
+	}
+
+	if len(n.children) != len(n.keys)+1 {
+		return 0, 0, fmt.Errorf("the node %v has %d children", n.keys, len(n.children))
+	}
+
+	count := len(n.keys)
+	height := 0
+	for i, child := range n.children {
+		childLo, childHi := lo, hi
+		if i > 0 {
+			childLo = &n.keys[i-1]
+		}
+		if i < len(n.keys) {
+			childHi = &n.keys[i]
+		}
+
+		childCount, childHeight, err := validate(t, child, childLo, childHi)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if i > 0 && childHeight != height {
+			return 0, 0, fmt.Errorf("the children of %v have the heights %d and %d", n.keys, height, childHeight)
+		}
+
+		count += childCount
+		height = childHeight
+	}
+
+	return count, height + 1, nil
+}
+
+// All yields key-value pairs in the order of keys, failing fast as ordered.OrderedMap describes
+func All[K, V any](t *Tree[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		walk(t, t.root, nil, nil, t.mods, yield)
+	}
+}
+
+// Range yields key-value pairs with keys between lo and hi, both inclusive, in the order of keys.
+// It skips the subtrees which are out of the range, so it's O(log n + k) for k keys in it
+func Range[K, V any](t *Tree[K, V], lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		walk(t, t.root, &lo, &hi, t.mods, yield)
+	}
+}
+
+func Keys[K, V any](t *Tree[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range All(t) {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+func Values[K, V any](t *Tree[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range All(t) {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// walk yields the keys of the subtree of n which are between lo and hi if those aren't nil.
+// It returns false once it's time to stop: the loop body broke out or the keys went past hi
+func walk[K, V any](t *Tree[K, V], n *node[K, V], lo, hi *K, mods int, yield func(K, V) bool) bool {
+	// the keys and the children before the first key not less than lo are all less than lo
+	i := 0
+	if lo != nil {
+		i, _ = search(t, n, *lo)
+	}
+
+	for ; i < len(n.keys); i++ {
+		if !n.leaf() && !walk(t, n.children[i], lo, hi, mods, yield) {
+			return false
+		}
+
+		if hi != nil && t.compare(n.keys[i], *hi) > 0 {
+			return false
+		}
+
+		if !yield(n.keys[i], n.values[i]) {
+			return false
+		}
+		checkMods(t, mods)
+	}
+
+	if !n.leaf() {
+		return walk(t, n.children[len(n.keys)], lo, hi, mods, yield)
+	}
+
+	return true
+}
+
+func checkMods[K, V any](t *Tree[K, V], mods int) {
+	if t.mods != mods {
+		panic(ErrConcurrentModification)
+	}
+}
+
+func none[K, V any]() (K, V, bool) {
+	var key K
+	var value V
+	return key, value, false
+}
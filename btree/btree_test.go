@@ -0,0 +1,146 @@
+package btree
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/kirillrogovoy/computer-science/ordered"
+	"github.com/kirillrogovoy/computer-science/ordered/orderedtest"
+	"github.com/stretchr/testify/require"
+)
+
+var degrees = []int{2, 3, 16}
+
+func TestConformance(t *testing.T) {
+	for _, degree := range degrees {
+		t.Run(fmt.Sprint(degree), func(t *testing.T) {
+			orderedtest.Run(t, func() ordered.OrderedMap[int, int] {
+				return New[int, int](degree)
+			})
+		})
+	}
+}
+
+func TestDegree(t *testing.T) {
+	require.Panics(t, func() { New[int, int](1) })
+	require.Equal(t, 3, New[int, int](3).Degree())
+}
+
+func TestSplit(t *testing.T) {
+	tree := New[int, int](2)
+	for key := 1; key <= 3; key++ {
+		tree.Put(key, key)
+	}
+	require.Equal(t, 1, tree.Height())
+	require.Equal(t, []int{1, 2, 3}, tree.root.keys)
+
+	// the full root splits around its median on the next put
+	tree.Put(4, 4)
+	require.Equal(t, 2, tree.Height())
+	require.Equal(t, []int{2}, tree.root.keys)
+	require.Equal(t, []int{1}, tree.root.children[0].keys)
+	require.Equal(t, []int{3, 4}, tree.root.children[1].keys)
+	require.NoError(t, tree.Validate())
+}
+
+func TestBorrowAndMerge(t *testing.T) {
+	//        [2 4]
+	//   [1]  [3]  [5 6]
+	tree := New[int, int](2)
+	for _, key := range []int{1, 2, 3, 4, 5, 6} {
+		tree.Put(key, key)
+	}
+	require.Equal(t, []int{2, 4}, tree.root.keys)
+
+	// [3] is minimal, so it borrows 4 from the root which takes 5 from [5 6]
+	tree.Delete(3)
+	require.Equal(t, []int{2, 5}, tree.root.keys)
+	require.Equal(t, []int{4}, tree.root.children[1].keys)
+	require.NoError(t, tree.Validate())
+
+	// [1] and [4] are both minimal, so they merge with 2 between them
+	tree.Delete(1)
+	require.Equal(t, []int{5}, tree.root.keys)
+	require.Equal(t, []int{2, 4}, tree.root.children[0].keys)
+	require.NoError(t, tree.Validate())
+
+	// the root loses its last key to a merge and the tree gets lower
+	tree.Delete(6)
+	tree.Delete(5)
+	require.Equal(t, 1, tree.Height())
+	require.Equal(t, []int{2, 4}, tree.root.keys)
+	require.NoError(t, tree.Validate())
+}
+
+func TestRange(t *testing.T) {
+	for _, degree := range degrees {
+		tree := New[int, string](degree)
+		for key := 0; key < 1000; key += 3 {
+			tree.Put(key, fmt.Sprint(key))
+		}
+
+		random := rand.New(rand.NewSource(int64(degree)))
+		for i := 0; i < 100; i++ {
+			lo, hi := random.Intn(1100)-50, random.Intn(1100)-50
+
+			expected := []int{}
+			for key := 0; key < 1000; key += 3 {
+				if key >= lo && key <= hi {
+					expected = append(expected, key)
+				}
+			}
+
+			actual := []int{}
+			for key, value := range tree.Range(lo, hi) {
+				require.Equal(t, fmt.Sprint(key), value)
+				actual = append(actual, key)
+			}
+			require.Equal(t, expected, actual, "range [%d, %d]", lo, hi)
+		}
+
+		// stopping early
+		count := 0
+		for range tree.Range(0, 1000) {
+			count++
+			if count == 5 {
+				break
+			}
+		}
+		require.Equal(t, 5, count)
+	}
+}
+
+func TestHeight(t *testing.T) {
+	tree := New[int, int](16)
+	for key := 0; key < 100000; key++ {
+		tree.Put(key, key)
+	}
+
+	// every node but the root has at least 15 keys and 16 children
+	require.LessOrEqual(t, tree.Height(), 5)
+	require.NoError(t, tree.Validate())
+	require.Equal(t, 100000, len(slices.Collect(tree.Keys())))
+}
+
+func TestValidate(t *testing.T) {
+	tree := New[int, int](2)
+	for key := range 10 {
+		tree.Put(key, key)
+	}
+	require.NoError(t, Validate(tree))
+
+	leaf := minNode(tree.root)
+	leaf.keys[0] = 100
+	require.EqualError(t, Validate(tree), "the key 100 is out of order")
+	leaf.keys[0] = 0
+
+	keys, values := leaf.keys, leaf.values
+	leaf.keys, leaf.values = nil, nil
+	require.EqualError(t, Validate(tree), "the node [] has 0 keys, out of [1, 3]")
+	leaf.keys, leaf.values = keys, values
+
+	tree.size++
+	require.EqualError(t, Validate(tree), "the tree has 10 keys, but its size is 11")
+}
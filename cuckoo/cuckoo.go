@@ -0,0 +1,323 @@
+// Package cuckoo is a cuckoo hash map, and its set package a set on top of it. Every
+// key has one possible slot in each of two tables, so a lookup of a key in the tables
+// probes at most two slots no matter how full they are. A put which finds both slots
+// taken kicks the occupant out to its other slot, which may kick out another key and
+// so on. The few keys which find no place end up in a small stash, which lookups of
+// them and of absent keys scan too
+package cuckoo
+
+import (
+	"hash/maphash"
+	"math/bits"
+
+	"github.com/kirillrogovoy/computer-science/bounds"
+)
+
+var ErrConcurrentModification = bounds.ErrConcurrentModification
+
+const (
+	// minCapacity is the smallest number of slots in each table
+	minCapacity = 8
+	// maxLoad is the ratio of items to the slots of both tables above which they grow.
+	// Two tables with one slot per key start failing puts often above 1/2
+	maxLoad = 0.5
+	// StashCapacity is how many keys which didn't find a place in the tables can be
+	// kept aside before the tables are rehashed with new seeds
+	StashCapacity = 4
+	// rehashAttempts is how many times a rehash tries new seeds before it grows the tables
+	rehashAttempts = 4
+)
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+type slot[K comparable, V any] struct {
+	entry[K, V]
+	full bool
+}
+
+// Statistics tells how hard the map had to work to place its keys
+type Statistics struct {
+	// Kicks is the number of keys moved to their other slot
+	Kicks int
+	// Cycles is the number of puts which ran into a cycle of kicks or the kick limit.
+	// The keys a rehash puts back into the new tables don't count
+	Cycles int
+	// Rehashes is the number of times the tables were rebuilt with new seeds
+	Rehashes int
+	// Stashed is the number of keys in the stash now
+	Stashed int
+	// MaxStashed is the greatest number of keys the stash has ever held
+	MaxStashed int
+}
+
+type Map[K comparable, V any] struct {
+	tables [2][]slot[K, V]
+	seeds  [2]maphash.Seed
+	// stash keeps the keys which couldn't be placed, lookups check it after the tables
+	stash []entry[K, V]
+	size  int
+	stats Statistics
+	// mods counts structural modifications so that iterators can fail fast
+	mods int
+}
+
+func New[K comparable, V any]() *Map[K, V] {
+	m := &Map[K, V]{}
+	reset(m, minCapacity)
+	return m
+}
+
+func Len[K comparable, V any](m *Map[K, V]) int {
+	return m.size
+}
+
+func Empty[K comparable, V any](m *Map[K, V]) bool {
+	return m.size == 0
+}
+
+// Cap returns the number of slots in both tables
+func Cap[K comparable, V any](m *Map[K, V]) int {
+	return 2 * len(m.tables[0])
+}
+
+func Stats[K comparable, V any](m *Map[K, V]) Statistics {
+	stats := m.stats
+	stats.Stashed = len(m.stash)
+	return stats
+}
+
+func Get[K comparable, V any](m *Map[K, V], key K) (V, bool) {
+	table, pos, _ := find(m, key)
+
+	switch {
+	case table < 0:
+		var zero V
+		return zero, false
+	case table == len(m.tables):
+		return m.stash[pos].value, true
+	default:
+		return m.tables[table][pos].value, true
+	}
+}
+
+func Contains[K comparable, V any](m *Map[K, V], key K) bool {
+	_, ok := Get(m, key)
+	return ok
+}
+
+// Put sets the value of key and tells if the key is new
+func Put[K comparable, V any](m *Map[K, V], key K, value V) bool {
+	table, pos, _ := find(m, key)
+	switch {
+	case table == len(m.tables):
+		m.stash[pos].value = value
+		return false
+	case table >= 0:
+		m.tables[table][pos].value = value
+		return false
+	}
+
+	if float64(m.size+1) > maxLoad*float64(Cap(m)) {
+		rehash(m, 2*len(m.tables[0]))
+	}
+
+	homeless, ok := insert(m, entry[K, V]{key, value})
+	if !ok {
+		m.stats.Cycles++
+		if !stash(m, homeless) {
+			rehash(m, len(m.tables[0]), homeless)
+		}
+	}
+
+	m.size++
+	m.mods++
+	return true
+}
+
+// Delete removes key and tells if it was there
+func Delete[K comparable, V any](m *Map[K, V], key K) bool {
+	table, pos, _ := find(m, key)
+	switch {
+	case table < 0:
+		return false
+	case table == len(m.tables):
+		last := len(m.stash) - 1
+		m.stash[pos] = m.stash[last]
+		m.stash[last] = entry[K, V]{}
+		m.stash = m.stash[:last]
+	default:
+		m.tables[table][pos] = slot[K, V]{}
+		unstash(m)
+	}
+
+	m.size--
+	m.mods++
+
+	// shrink when a quarter full relative to the max load
+	capacity := len(m.tables[0])
+	if capacity > minCapacity && float64(m.size) <= maxLoad/4*float64(Cap(m)) {
+		rehash(m, capacity/2)
+	}
+
+	return true
+}
+
+// find returns where key is: the table and the slot in it, len(m.tables) and the index
+// in the stash, or -1 if it's absent. It also returns the number of probes:
+// the table slots and the stash entries it looked at
+func find[K comparable, V any](m *Map[K, V], key K) (int, int, int) {
+	probes := 0
+	for table := range m.tables {
+		pos := position(m, table, key)
+		probes++
+
+		s := &m.tables[table][pos]
+		if s.full && s.key == key {
+			return table, pos, probes
+		}
+	}
+
+	for i, e := range m.stash {
+		probes++
+		if e.key == key {
+			return len(m.tables), i, probes
+		}
+	}
+
+	return -1, -1, probes
+}
+
+func position[K comparable, V any](m *Map[K, V], table int, key K) int {
+	return int(maphash.Comparable(m.seeds[table], key) & uint64(len(m.tables[table])-1))
+}
+
+// insert places e kicking other keys around as needed. If that doesn't work out,
+// it returns the key which is left without a slot, which isn't necessarily e
+func insert[K comparable, V any](m *Map[K, V], e entry[K, V]) (entry[K, V], bool) {
+	// take a free slot right away if there's one
+	for table := range m.tables {
+		s := &m.tables[table][position(m, table, e.key)]
+		if !s.full {
+			*s = slot[K, V]{e, true}
+			return entry[K, V]{}, true
+		}
+	}
+
+	// a long path of kicks means the tables are too crowded, so give up early
+	maxKicks := 4 * bits.Len(uint(len(m.tables[0])))
+
+	cur := e
+	table := 0
+	returns := 0
+	for kicks := 0; kicks < maxKicks; kicks++ {
+		s := &m.tables[table][position(m, table, cur.key)]
+		if !s.full {
+			*s = slot[K, V]{cur, true}
+			return entry[K, V]{}, true
+		}
+
+		cur, s.entry = s.entry, cur
+		m.stats.Kicks++
+
+		// e is kicked out of its first slot when the path loops back to it,
+		// and out of the second one when there is a second loop which means no way out
+		if cur.key == e.key {
+			returns++
+			if returns == 2 {
+				break
+			}
+		}
+
+		table = 1 - table
+	}
+
+	return cur, false
+}
+
+// stash keeps e aside if there's room for it
+func stash[K comparable, V any](m *Map[K, V], e entry[K, V]) bool {
+	if len(m.stash) == StashCapacity {
+		return false
+	}
+
+	m.stash = append(m.stash, e)
+	m.stats.MaxStashed = max(m.stats.MaxStashed, len(m.stash))
+	return true
+}
+
+// unstash moves the stashed keys which have a free slot now back into the tables
+func unstash[K comparable, V any](m *Map[K, V]) {
+	kept := m.stash[:0]
+	for _, e := range m.stash {
+		placed := false
+		for table := range m.tables {
+			s := &m.tables[table][position(m, table, e.key)]
+			if !s.full {
+				*s = slot[K, V]{e, true}
+				placed = true
+				break
+			}
+		}
+
+		if !placed {
+			kept = append(kept, e)
+		}
+	}
+
+	clear(m.stash[len(kept):])
+	m.stash = kept
+}
+
+// reset empties the tables giving them capacity slots each and new seeds
+func reset[K comparable, V any](m *Map[K, V], capacity int) {
+	for table := range m.tables {
+		m.tables[table] = make([]slot[K, V], capacity)
+		m.seeds[table] = maphash.MakeSeed()
+	}
+
+	m.stash = nil
+}
+
+// rehash rebuilds the tables with capacity slots each and new seeds, adding extra.
+// Seeds which lead to a key left without a place are thrown away, and after
+// a few such attempts the tables grow
+func rehash[K comparable, V any](m *Map[K, V], capacity int, extra ...entry[K, V]) {
+	entries := extra
+	for _, table := range m.tables {
+		for _, s := range table {
+			if s.full {
+				entries = append(entries, s.entry)
+			}
+		}
+	}
+	entries = append(entries, m.stash...)
+
+	for attempt := 1; ; attempt++ {
+		reset(m, capacity)
+		m.stats.Rehashes++
+
+		if insertAll(m, entries) {
+			break
+		}
+
+		if attempt%rehashAttempts == 0 {
+			capacity *= 2
+		}
+	}
+
+	m.mods++
+}
+
+func insertAll[K comparable, V any](m *Map[K, V], entries []entry[K, V]) bool {
+	for _, e := range entries {
+		homeless, ok := insert(m, e)
+		if !ok && !stash(m, homeless) {
+			return false
+		}
+	}
+
+	return true
+}
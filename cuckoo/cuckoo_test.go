@@ -0,0 +1,226 @@
+package cuckoo
+
+import (
+	"encoding/binary"
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// requireMap compares the map with a builtin one and checks that every key
+// is in one of its two slots or in the stash
+func requireMap(t *testing.T, m *Map[int, int], model map[int]int) {
+	require.Equal(t, len(model), Len(m))
+	require.Equal(t, model, maps.Collect(All(m)))
+	require.LessOrEqual(t, len(m.stash), StashCapacity)
+
+	for key, value := range model {
+		actual, ok := Get(m, key)
+		require.Equal(t, true, ok)
+		require.Equal(t, value, actual)
+
+		requireProbes(t, m, key)
+	}
+}
+
+// requireProbes checks that a lookup of a key in the tables probes at most its two slots,
+// and that a lookup of any other key probes both of them and then the stash up to the key
+func requireProbes[K comparable, V any](t *testing.T, m *Map[K, V], key K) {
+	table, pos, probes := find(m, key)
+	switch {
+	case table < 0:
+		require.Equal(t, 2+len(m.stash), probes)
+	case table == len(m.tables):
+		require.Equal(t, 2+pos+1, probes)
+	default:
+		require.LessOrEqual(t, probes, 2)
+	}
+
+	require.LessOrEqual(t, probes, 2+StashCapacity)
+}
+
+func TestRandomOperations(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+	m := New[int, int]()
+	model := map[int]int{}
+
+	for i := 0; i < 20000; i++ {
+		key := random.Intn(2000)
+		_, exists := model[key]
+
+		if random.Intn(3) > 0 {
+			require.Equal(t, !exists, Put(m, key, i))
+			model[key] = i
+		} else {
+			require.Equal(t, exists, Delete(m, key))
+			delete(model, key)
+		}
+
+		require.Equal(t, len(model), Len(m))
+	}
+
+	requireMap(t, m, model)
+}
+
+func TestGrowAndShrink(t *testing.T) {
+	m := New[int, int]()
+	require.Equal(t, 16, m.Cap())
+
+	for key := 0; key < 1000; key++ {
+		m.Put(key, key)
+		require.LessOrEqual(t, float64(m.Len()), 0.5*float64(m.Cap()))
+	}
+
+	for key := 0; key < 1000; key++ {
+		require.Equal(t, true, m.Delete(key))
+	}
+
+	require.Equal(t, 16, m.Cap())
+	require.Equal(t, true, m.Empty())
+}
+
+func TestCycle(t *testing.T) {
+	m := New[int, int]()
+
+	// make every key go to slot 0 of both tables, so the third key can't be placed by kicking
+	for table := range m.tables {
+		m.tables[table] = m.tables[table][:1]
+	}
+
+	insert(m, entry[int, int]{1, 1})
+	insert(m, entry[int, int]{2, 2})
+
+	homeless, ok := insert(m, entry[int, int]{3, 3})
+	require.Equal(t, false, ok)
+
+	// the key left without a place is one of the three
+	require.Equal(t, true, slices.Contains([]int{1, 2, 3}, homeless.key))
+}
+
+func TestRehashDoesntCountCycles(t *testing.T) {
+	m := New[int, int]()
+	for key := 0; key < 50; key++ {
+		m.Put(key, key)
+	}
+	stats := m.Stats()
+
+	// a single slot per table can't hold 50 keys, so the rebuild fails with cycles and grows
+	rehash(m, 1)
+	require.Less(t, stats.Rehashes, m.Stats().Rehashes)
+	require.Equal(t, stats.Cycles, m.Stats().Cycles)
+	require.Equal(t, 50, len(maps.Collect(m.All())))
+}
+
+func TestStash(t *testing.T) {
+	m := New[int, int]()
+
+	// a single slot per table: the third key onwards goes to the stash
+	for table := range m.tables {
+		m.tables[table] = m.tables[table][:1]
+	}
+
+	for key := 0; key < 2+StashCapacity; key++ {
+		homeless, ok := insert(m, entry[int, int]{key, key})
+		if !ok {
+			require.Equal(t, true, stash(m, homeless))
+		}
+		m.size++
+	}
+
+	stats := m.Stats()
+	require.Equal(t, StashCapacity, stats.Stashed)
+	require.Equal(t, StashCapacity, stats.MaxStashed)
+
+	// the stashed keys are found too, after probing both slots and the stash entries before them
+	for key := 0; key < 2+StashCapacity; key++ {
+		require.Equal(t, true, m.Contains(key))
+	}
+	for i, e := range m.stash {
+		table, pos, probes := find(m, e.key)
+		require.Equal(t, len(m.tables), table)
+		require.Equal(t, i, pos)
+		require.Equal(t, 2+i+1, probes)
+	}
+
+	// an absent key is looked for everywhere
+	_, _, probes := find(m, -1)
+	require.Equal(t, 2+StashCapacity, probes)
+
+	// freeing a slot brings a stashed key back into the tables
+	m.Delete(m.tables[0][0].key)
+	require.Equal(t, StashCapacity-1, m.Stats().Stashed)
+
+	// the next put finds the tables overloaded and rebuilds them with new seeds
+	rehashes := m.Stats().Rehashes
+	m.Put(100, 100)
+	m.Put(101, 101)
+	require.Less(t, rehashes, m.Stats().Rehashes)
+	require.Equal(t, 2+StashCapacity+1, m.Len())
+}
+
+func TestIterators(t *testing.T) {
+	m := New[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	sum := 0
+	for value := range m.Values() {
+		sum += value
+	}
+	require.Equal(t, 3, sum)
+
+	// updating values while iterating is fine
+	for key, value := range m.All() {
+		m.Put(key, value*10)
+	}
+	value, _ := m.Get("a")
+	require.Equal(t, 10, value)
+
+	require.Panics(t, func() {
+		for key := range m.Keys() {
+			m.Delete(key)
+		}
+	})
+}
+
+// FuzzLookup runs a sequence of puts and deletes decoded from the input and checks
+// that every lookup, of a present or an absent key, probes at most two table slots
+// and the stash
+func FuzzLookup(f *testing.F) {
+	f.Add([]byte{0, 1, 0, 2, 0, 3, 1, 2})
+	f.Add(make([]byte, 512))
+	seed := make([]byte, 4096)
+	rand.New(rand.NewSource(1)).Read(seed)
+	f.Add(seed)
+
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		m := New[uint16, int]()
+		model := map[uint16]int{}
+
+		for i := 0; i+2 < len(ops); i += 3 {
+			key := binary.LittleEndian.Uint16(ops[i+1:])
+			if ops[i]%4 == 0 {
+				Delete(m, key)
+				delete(model, key)
+			} else {
+				Put(m, key, i)
+				model[key] = i
+			}
+
+			requireProbes(t, m, key)
+		}
+
+		require.Equal(t, len(model), Len(m))
+		for key := 0; key < 1<<16; key += 97 {
+			value, ok := Get(m, uint16(key))
+			expected, exists := model[uint16(key)]
+			require.Equal(t, exists, ok)
+			require.Equal(t, expected, value)
+
+			requireProbes(t, m, uint16(key))
+		}
+	})
+}
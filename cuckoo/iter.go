@@ -0,0 +1,60 @@
+package cuckoo
+
+import (
+	"iter"
+)
+
+// All yields key-value pairs in no particular order.
+// Like the rest of the iterators, it panics with ErrConcurrentModification
+// if the loop body adds or deletes keys. Updating the value of a key is fine
+func All[K comparable, V any](m *Map[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		mods := m.mods
+		for _, table := range m.tables {
+			for _, s := range table {
+				if !s.full {
+					continue
+				}
+
+				if !yield(s.key, s.value) {
+					return
+				}
+				checkMods(m, mods)
+			}
+		}
+
+		for i := 0; i < len(m.stash); i++ {
+			e := m.stash[i]
+			if !yield(e.key, e.value) {
+				return
+			}
+			checkMods(m, mods)
+		}
+	}
+}
+
+func Keys[K comparable, V any](m *Map[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range All(m) {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+func Values[K comparable, V any](m *Map[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range All(m) {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+func checkMods[K comparable, V any](m *Map[K, V], mods int) {
+	if m.mods != mods {
+		panic(ErrConcurrentModification)
+	}
+}
@@ -0,0 +1,51 @@
+package cuckoo
+
+import (
+	"iter"
+)
+
+// Method forms of the package-level functions
+
+func (m *Map[K, V]) Len() int {
+	return Len(m)
+}
+
+func (m *Map[K, V]) Empty() bool {
+	return Empty(m)
+}
+
+func (m *Map[K, V]) Cap() int {
+	return Cap(m)
+}
+
+func (m *Map[K, V]) Stats() Statistics {
+	return Stats(m)
+}
+
+func (m *Map[K, V]) Get(key K) (V, bool) {
+	return Get(m, key)
+}
+
+func (m *Map[K, V]) Contains(key K) bool {
+	return Contains(m, key)
+}
+
+func (m *Map[K, V]) Put(key K, value V) bool {
+	return Put(m, key, value)
+}
+
+func (m *Map[K, V]) Delete(key K) bool {
+	return Delete(m, key)
+}
+
+func (m *Map[K, V]) All() iter.Seq2[K, V] {
+	return All(m)
+}
+
+func (m *Map[K, V]) Keys() iter.Seq[K] {
+	return Keys(m)
+}
+
+func (m *Map[K, V]) Values() iter.Seq[V] {
+	return Values(m)
+}
@@ -0,0 +1,35 @@
+package set
+
+import (
+	"iter"
+)
+
+// Method forms of the package-level functions
+
+func (s *Set[K]) Len() int {
+	return Len(s)
+}
+
+func (s *Set[K]) Empty() bool {
+	return Empty(s)
+}
+
+func (s *Set[K]) Stats() Statistics {
+	return Stats(s)
+}
+
+func (s *Set[K]) Contains(key K) bool {
+	return Contains(s, key)
+}
+
+func (s *Set[K]) Put(key K) bool {
+	return Put(s, key)
+}
+
+func (s *Set[K]) Delete(key K) bool {
+	return Delete(s, key)
+}
+
+func (s *Set[K]) All() iter.Seq[K] {
+	return All(s)
+}
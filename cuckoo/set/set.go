@@ -0,0 +1,51 @@
+// Package set is a set on the cuckoo hash map, a map without values
+package set
+
+import (
+	"iter"
+
+	"github.com/kirillrogovoy/computer-science/cuckoo"
+)
+
+var ErrConcurrentModification = cuckoo.ErrConcurrentModification
+
+type Statistics = cuckoo.Statistics
+
+type Set[K comparable] struct {
+	m *cuckoo.Map[K, struct{}]
+}
+
+func New[K comparable]() *Set[K] {
+	return &Set[K]{cuckoo.New[K, struct{}]()}
+}
+
+func Len[K comparable](s *Set[K]) int {
+	return cuckoo.Len(s.m)
+}
+
+func Empty[K comparable](s *Set[K]) bool {
+	return cuckoo.Empty(s.m)
+}
+
+func Stats[K comparable](s *Set[K]) Statistics {
+	return cuckoo.Stats(s.m)
+}
+
+func Contains[K comparable](s *Set[K], key K) bool {
+	return cuckoo.Contains(s.m, key)
+}
+
+// Put adds key to the set and tells if it's new
+func Put[K comparable](s *Set[K], key K) bool {
+	return cuckoo.Put(s.m, key, struct{}{})
+}
+
+// Delete takes key out of the set and tells if it was there
+func Delete[K comparable](s *Set[K], key K) bool {
+	return cuckoo.Delete(s.m, key)
+}
+
+// All yields the keys in no particular order
+func All[K comparable](s *Set[K]) iter.Seq[K] {
+	return cuckoo.Keys(s.m)
+}
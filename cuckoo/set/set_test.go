@@ -0,0 +1,41 @@
+package set
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSet(t *testing.T) {
+	s := New[string]()
+	require.Equal(t, true, s.Empty())
+	require.Equal(t, true, s.Put("x"))
+	require.Equal(t, false, s.Put("x"))
+	require.Equal(t, true, Put(s, "y"))
+	require.Equal(t, 2, s.Len())
+
+	require.Equal(t, true, s.Contains("x"))
+	require.Equal(t, true, s.Delete("x"))
+	require.Equal(t, false, Delete(s, "x"))
+	require.Equal(t, false, Contains(s, "x"))
+
+	members := []string{}
+	for key := range s.All() {
+		members = append(members, key)
+	}
+	require.Equal(t, []string{"y"}, members)
+	require.Equal(t, 0, s.Stats().Stashed)
+}
+
+func TestConcurrentModification(t *testing.T) {
+	s := New[int]()
+	for key := range 10 {
+		s.Put(key)
+	}
+
+	require.PanicsWithValue(t, ErrConcurrentModification, func() {
+		for key := range s.All() {
+			s.Delete(key)
+		}
+	})
+}
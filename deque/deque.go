@@ -0,0 +1,229 @@
+package deque
+
+import (
+	"fmt"
+
+	"github.com/kirillrogovoy/computer-science/arrayGeneric"
+	"github.com/kirillrogovoy/computer-science/bounds"
+)
+
+var (
+	ErrIndexOutOfRange        = bounds.ErrIndexOutOfRange
+	ErrEmpty                  = bounds.ErrEmpty
+	ErrConcurrentModification = bounds.ErrConcurrentModification
+)
+
+type IndexError = bounds.IndexError
+
+// policy is the default one of the dynamic array: capacities are powers of 2 starting from 16,
+// the buffer doubles when it's full and halves when it's 1/4 full.
+// Powers of 2 let the ring wrap its indices with a bit mask
+var policy = arrayGeneric.DefaultGrowthPolicy()
+
+// Deque is a ring buffer. The items occupy buf[head], buf[head+1], ... wrapping around
+// the end of buf, so both ends can grow and shrink without shifting the rest
+type Deque[T any] struct {
+	buf  []T
+	head int
+	size int
+	// mods counts structural modifications so that iterators can fail fast
+	mods int
+}
+
+func Create[T any](initialCap int) *Deque[T] {
+	return &Deque[T]{make([]T, policy.Capacity(initialCap)), 0, 0, 0}
+}
+
+func Cap[T any](d *Deque[T]) int {
+	return len(d.buf)
+}
+
+func Size[T any](d *Deque[T]) int {
+	return d.size
+}
+
+func IsEmpty[T any](d *Deque[T]) bool {
+	return d.size == 0
+}
+
+// slot maps an index counted from the front to the index in buf
+func slot[T any](d *Deque[T], index int) int {
+	return (d.head + index) & (len(d.buf) - 1)
+}
+
+func resize[T any](d *Deque[T], newCapacity int) {
+	if newCapacity == Cap(d) {
+		return
+	}
+
+	size := Size(d)
+
+	if newCapacity < size {
+		panic(fmt.Sprintf(
+			"Tried to resize a deque with size %d to the capacity %d which is smaller",
+			size,
+			newCapacity,
+		))
+	}
+
+	// unwrap the ring so that the front is at 0 again
+	newBuf := make([]T, newCapacity)
+	for i := 0; i < size; i++ {
+		newBuf[i] = d.buf[slot(d, i)]
+	}
+
+	d.buf = newBuf
+	d.head = 0
+	d.mods++
+}
+
+func grow[T any](d *Deque[T]) {
+	if Size(d) == Cap(d) {
+		resize(d, policy.Grow(Cap(d)))
+	}
+}
+
+func shrink[T any](d *Deque[T]) {
+	resize(d, policy.Shrink(Size(d), Cap(d)))
+}
+
+func PushBack[T any](d *Deque[T], item T) {
+	grow(d)
+
+	d.buf[slot(d, d.size)] = item
+	d.size++
+	d.mods++
+}
+
+func PushFront[T any](d *Deque[T], item T) {
+	grow(d)
+
+	d.head = slot(d, -1)
+	d.buf[d.head] = item
+	d.size++
+	d.mods++
+}
+
+func PopBack[T any](d *Deque[T]) T {
+	item, err := TryPopBack(d)
+	if err != nil {
+		panic(err)
+	}
+
+	return item
+}
+
+func TryPopBack[T any](d *Deque[T]) (T, error) {
+	var zero T
+	if IsEmpty(d) {
+		return zero, bounds.ErrEmpty
+	}
+
+	last := slot(d, d.size-1)
+	item := d.buf[last]
+
+	// let the garbage collector have what the item refers to
+	d.buf[last] = zero
+	d.size--
+	d.mods++
+
+	shrink(d)
+	return item, nil
+}
+
+func PopFront[T any](d *Deque[T]) T {
+	item, err := TryPopFront(d)
+	if err != nil {
+		panic(err)
+	}
+
+	return item
+}
+
+func TryPopFront[T any](d *Deque[T]) (T, error) {
+	var zero T
+	if IsEmpty(d) {
+		return zero, bounds.ErrEmpty
+	}
+
+	item := d.buf[d.head]
+
+	d.buf[d.head] = zero
+	d.head = slot(d, 1)
+	d.size--
+	d.mods++
+
+	shrink(d)
+	return item, nil
+}
+
+func Front[T any](d *Deque[T]) T {
+	item, err := TryFront(d)
+	if err != nil {
+		panic(err)
+	}
+
+	return item
+}
+
+func TryFront[T any](d *Deque[T]) (T, error) {
+	if IsEmpty(d) {
+		var zero T
+		return zero, bounds.ErrEmpty
+	}
+
+	return TryAt(d, 0)
+}
+
+func Back[T any](d *Deque[T]) T {
+	item, err := TryBack(d)
+	if err != nil {
+		panic(err)
+	}
+
+	return item
+}
+
+func TryBack[T any](d *Deque[T]) (T, error) {
+	if IsEmpty(d) {
+		var zero T
+		return zero, bounds.ErrEmpty
+	}
+
+	return TryAt(d, -1)
+}
+
+func At[T any](d *Deque[T], index int) T {
+	item, err := TryAt(d, index)
+	if err != nil {
+		panic(err)
+	}
+
+	return item
+}
+
+func TryAt[T any](d *Deque[T], index int) (T, error) {
+	index, err := bounds.Normalize(index, Size(d))
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return d.buf[slot(d, index)], nil
+}
+
+func Set[T any](d *Deque[T], index int, item T) {
+	if err := TrySet(d, index, item); err != nil {
+		panic(err)
+	}
+}
+
+func TrySet[T any](d *Deque[T], index int, item T) error {
+	index, err := bounds.Normalize(index, Size(d))
+	if err != nil {
+		return err
+	}
+
+	d.buf[slot(d, index)] = item
+	return nil
+}
@@ -0,0 +1,285 @@
+package deque
+
+import (
+	"errors"
+	"github.com/kirillrogovoy/computer-science/arrayGeneric"
+	"github.com/stretchr/testify/require"
+	"slices"
+	"testing"
+)
+
+func TestCreate(t *testing.T) {
+	// keys are "initialCap" and values are expected real capacity
+	testMap := map[int]int{
+		0:   16,
+		16:  16,
+		17:  32,
+		100: 128,
+	}
+
+	for initialCap, expectedCap := range testMap {
+		d := Create[int](initialCap)
+		require.Equal(t, expectedCap, Cap(d))
+		require.Equal(t, 0, Size(d))
+		require.Equal(t, true, IsEmpty(d))
+	}
+}
+
+func TestPushBack(t *testing.T) {
+	d := Create[int](16)
+
+	for i := 0; i < 16; i++ {
+		PushBack(d, i)
+		require.Equal(t, 16, Cap(d))
+		require.Equal(t, i+1, Size(d))
+	}
+
+	PushBack(d, 16)
+	require.Equal(t, 32, Cap(d))
+	require.Equal(t, 17, Size(d))
+
+	for i := 0; i < 17; i++ {
+		require.Equal(t, i, At(d, i))
+	}
+}
+
+func TestPushFront(t *testing.T) {
+	d := Create[int](16)
+
+	for i := 0; i < 17; i++ {
+		PushFront(d, i)
+		require.Equal(t, i, Front(d))
+		require.Equal(t, 0, Back(d))
+	}
+
+	require.Equal(t, 32, Cap(d))
+	for i := 0; i < 17; i++ {
+		require.Equal(t, 16-i, At(d, i))
+	}
+}
+
+func TestPop(t *testing.T) {
+	d := Create[int](16)
+	PushBack(d, 2)
+	PushFront(d, 1)
+	PushBack(d, 3)
+
+	require.Equal(t, 1, PopFront(d))
+	require.Equal(t, 3, PopBack(d))
+	require.Equal(t, 2, PopBack(d))
+	require.Equal(t, true, IsEmpty(d))
+
+	_, err := TryPopFront(d)
+	require.Equal(t, ErrEmpty, err)
+	_, err = TryPopBack(d)
+	require.Equal(t, ErrEmpty, err)
+	_, err = TryFront(d)
+	require.Equal(t, ErrEmpty, err)
+	_, err = TryBack(d)
+	require.Equal(t, ErrEmpty, err)
+}
+
+func TestPopPanic(t *testing.T) {
+	for name, pop := range map[string]func(d *Deque[int]) int{
+		"PopFront": PopFront[int],
+		"PopBack":  PopBack[int],
+		"Front":    Front[int],
+		"Back":     Back[int],
+	} {
+		func() {
+			defer func() {
+				err, _ := recover().(error)
+				require.Equal(t, true, errors.Is(err, ErrEmpty), name+"() should panic with ErrEmpty")
+			}()
+
+			pop(Create[int](16))
+		}()
+	}
+}
+
+func TestWrapAround(t *testing.T) {
+	d := Create[int](16)
+
+	// move the head around the ring a few times keeping the size at 10
+	for i := 0; i < 10; i++ {
+		PushBack(d, i)
+	}
+	for i := 10; i < 100; i++ {
+		require.Equal(t, i-10, PopFront(d))
+		PushBack(d, i)
+		require.Equal(t, 16, Cap(d))
+	}
+
+	expected := []int{}
+	for i := 90; i < 100; i++ {
+		expected = append(expected, i)
+	}
+	require.Equal(t, expected, slices.Collect(Values(d)))
+
+	// growing unwraps the ring keeping the order
+	for i := 100; i < 110; i++ {
+		PushBack(d, i)
+	}
+	require.Equal(t, 32, Cap(d))
+	require.Equal(t, 0, d.head)
+	for i := 0; i < 20; i++ {
+		require.Equal(t, 90+i, At(d, i))
+	}
+}
+
+func TestShrink(t *testing.T) {
+	d := Create[int](32)
+	for i := 0; i < 32; i++ {
+		PushFront(d, i)
+	}
+
+	for i := 0; i < 23; i++ {
+		PopBack(d)
+		require.Equal(t, 32, Cap(d))
+	}
+
+	require.Equal(t, 9, Size(d))
+
+	// 8 is a quarter of 32
+	PopFront(d)
+	require.Equal(t, 8, Size(d))
+	require.Equal(t, 16, Cap(d))
+	for i := 0; i < 8; i++ {
+		require.Equal(t, 30-i, At(d, i))
+	}
+
+	// never shrink below 16
+	for !IsEmpty(d) {
+		PopFront(d)
+	}
+	require.Equal(t, 16, Cap(d))
+}
+
+func TestAt(t *testing.T) {
+	d := Create[string](16)
+	PushBack(d, "b")
+	PushFront(d, "a")
+	PushBack(d, "c")
+
+	require.Equal(t, "a", At(d, 0))
+	require.Equal(t, "c", At(d, -1))
+	require.Equal(t, "a", At(d, -3))
+
+	_, err := TryAt(d, 3)
+	require.Equal(t, &IndexError{Index: 3, Size: 3}, err)
+	_, err = TryAt(d, -4)
+	require.Equal(t, &IndexError{Index: -4, Size: 3}, err)
+}
+
+func TestSet(t *testing.T) {
+	d := Create[int](16)
+	PushFront(d, 1)
+	PushFront(d, 0)
+
+	Set(d, 0, 5)
+	Set(d, -1, 6)
+	require.Equal(t, []int{5, 6}, slices.Collect(Values(d)))
+
+	require.Equal(t, &IndexError{Index: 2, Size: 2}, TrySet(d, 2, 7))
+}
+
+func TestAtPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("At() should panic when out of bounds, but it didn't")
+		}
+	}()
+
+	At(Create[int](16), 0)
+}
+
+func TestResizePanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("resize() should panic when the new capacity is smaller than the size, but it didn't")
+		}
+	}()
+
+	d := Create[int](16)
+	for i := 0; i < 16; i++ {
+		PushBack(d, i)
+	}
+
+	resize(d, 8)
+}
+
+func TestIterators(t *testing.T) {
+	d := Create[int](16)
+	for i := 0; i < 20; i++ {
+		PushFront(d, i)
+	}
+
+	indices := []int{}
+	for i, item := range All(d) {
+		indices = append(indices, i)
+		require.Equal(t, 19-i, item)
+	}
+	require.Equal(t, 20, len(indices))
+
+	backward := []int{}
+	for i, item := range d.Backward() {
+		require.Equal(t, 19-i, item)
+		backward = append(backward, item)
+	}
+	require.Equal(t, []int{0, 1, 2}, backward[:3])
+}
+
+func TestConcurrentModification(t *testing.T) {
+	d := Create[int](16)
+	PushBack(d, 1)
+	PushBack(d, 2)
+
+	defer func() {
+		err, _ := recover().(error)
+		require.Equal(t, true, errors.Is(err, ErrConcurrentModification))
+	}()
+
+	for range All(d) {
+		PushFront(d, 0)
+	}
+
+	t.Error("the iterator should panic on a concurrent modification")
+}
+
+func TestMethods(t *testing.T) {
+	d := Create[int](16)
+	d.PushBack(2)
+	d.PushFront(1)
+
+	require.Equal(t, 2, d.Size())
+	require.Equal(t, 16, d.Cap())
+	require.Equal(t, 1, d.Front())
+	require.Equal(t, 2, d.Back())
+	require.Equal(t, 2, d.At(1))
+
+	d.Set(0, 0)
+	require.Equal(t, []int{0, 2}, slices.Collect(d.Values()))
+
+	require.Equal(t, 0, d.PopFront())
+	require.Equal(t, 2, d.PopBack())
+	require.Equal(t, true, d.IsEmpty())
+}
+
+func BenchmarkPushFront(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		d := Create[int](16)
+		for j := 0; j < 10000; j++ {
+			PushFront(d, j)
+		}
+	}
+}
+
+// the dynamic array shifts every item on Prepend, compare with BenchmarkPushFront
+func BenchmarkArrayPrepend(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		arr := arrayGeneric.Create[int](16)
+		for j := 0; j < 10000; j++ {
+			arrayGeneric.Prepend(arr, j)
+		}
+	}
+}
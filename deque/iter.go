@@ -0,0 +1,50 @@
+package deque
+
+import (
+	"iter"
+)
+
+// All yields index-item pairs from the front to the back.
+// Like the rest of the iterators, it panics with ErrConcurrentModification
+// if the loop body pushes or pops items
+func All[T any](d *Deque[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		mods := d.mods
+		for i := 0; i < Size(d); i++ {
+			if !yield(i, d.buf[slot(d, i)]) {
+				return
+			}
+			checkMods(d, mods)
+		}
+	}
+}
+
+func Values[T any](d *Deque[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range All(d) {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Backward yields index-item pairs from the back to the front
+func Backward[T any](d *Deque[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		mods := d.mods
+		for i := Size(d) - 1; i >= 0; i-- {
+			if !yield(i, d.buf[slot(d, i)]) {
+				return
+			}
+			checkMods(d, mods)
+		}
+	}
+}
+
+// checkMods fails fast when the deque was structurally modified since an iterator saw mods
+func checkMods[T any](d *Deque[T], mods int) {
+	if d.mods != mods {
+		panic(ErrConcurrentModification)
+	}
+}
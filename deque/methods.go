@@ -0,0 +1,87 @@
+package deque
+
+import (
+	"iter"
+)
+
+// Method forms of the package-level functions
+
+func (d *Deque[T]) Cap() int {
+	return Cap(d)
+}
+
+func (d *Deque[T]) Size() int {
+	return Size(d)
+}
+
+func (d *Deque[T]) IsEmpty() bool {
+	return IsEmpty(d)
+}
+
+func (d *Deque[T]) PushBack(item T) {
+	PushBack(d, item)
+}
+
+func (d *Deque[T]) PushFront(item T) {
+	PushFront(d, item)
+}
+
+func (d *Deque[T]) PopBack() T {
+	return PopBack(d)
+}
+
+func (d *Deque[T]) TryPopBack() (T, error) {
+	return TryPopBack(d)
+}
+
+func (d *Deque[T]) PopFront() T {
+	return PopFront(d)
+}
+
+func (d *Deque[T]) TryPopFront() (T, error) {
+	return TryPopFront(d)
+}
+
+func (d *Deque[T]) Front() T {
+	return Front(d)
+}
+
+func (d *Deque[T]) TryFront() (T, error) {
+	return TryFront(d)
+}
+
+func (d *Deque[T]) Back() T {
+	return Back(d)
+}
+
+func (d *Deque[T]) TryBack() (T, error) {
+	return TryBack(d)
+}
+
+func (d *Deque[T]) At(index int) T {
+	return At(d, index)
+}
+
+func (d *Deque[T]) TryAt(index int) (T, error) {
+	return TryAt(d, index)
+}
+
+func (d *Deque[T]) Set(index int, item T) {
+	Set(d, index, item)
+}
+
+func (d *Deque[T]) TrySet(index int, item T) error {
+	return TrySet(d, index, item)
+}
+
+func (d *Deque[T]) All() iter.Seq2[int, T] {
+	return All(d)
+}
+
+func (d *Deque[T]) Values() iter.Seq[T] {
+	return Values(d)
+}
+
+func (d *Deque[T]) Backward() iter.Seq2[int, T] {
+	return Backward(d)
+}
@@ -0,0 +1,255 @@
+package dlist
+
+import (
+	"github.com/kirillrogovoy/computer-science/bounds"
+)
+
+// The API mirrors the one of the singly linked list package,
+// but every operation at the back is O(1) thanks to the prev pointers
+
+var (
+	ErrIndexOutOfRange        = bounds.ErrIndexOutOfRange
+	ErrEmpty                  = bounds.ErrEmpty
+	ErrConcurrentModification = bounds.ErrConcurrentModification
+)
+
+type IndexError = bounds.IndexError
+
+type List struct {
+	first *Element
+	size  int
+	last  *Element
+	// mods counts structural modifications so that iterators can fail fast
+	mods int
+}
+
+func New() *List {
+	return &List{nil, 0, nil, 0}
+}
+
+func Size(l *List) int {
+	return l.size
+}
+
+func Empty(l *List) bool {
+	return l.size == 0
+}
+
+func Insert(l *List, index int, value int) bool {
+	return TryInsert(l, index, value) == nil
+}
+
+func TryInsert(l *List, index int, value int) error {
+	size := Size(l)
+
+	// allow negative index, means "from the end"
+	index, err := bounds.NormalizeInsert(index, size)
+	if err != nil {
+		return err
+	}
+
+	if index == 0 {
+		insertAfter(l, nil, value)
+	} else {
+		prev, _ := nodeAt(l, index-1)
+		insertAfter(l, prev, value)
+	}
+
+	return nil
+}
+
+func PushFront(l *List, value int) {
+	insertAfter(l, nil, value)
+}
+
+func PushBack(l *List, value int) {
+	insertAfter(l, l.last, value)
+}
+
+// nodeAt walks from whichever end is closer to the index
+func nodeAt(l *List, index int) (*Element, error) {
+	size := Size(l)
+
+	// allow negative index, means "from the end"
+	index, err := bounds.Normalize(index, size)
+	if err != nil {
+		return nil, err
+	}
+
+	if index < size/2 {
+		cur := l.first
+		for i := 0; i < index; i++ {
+			cur = cur.next
+		}
+		return cur, nil
+	}
+
+	cur := l.last
+	for i := size - 1; i > index; i-- {
+		cur = cur.prev
+	}
+	return cur, nil
+}
+
+func At(l *List, index int) (int, bool) {
+	value, err := TryAt(l, index)
+	return value, err == nil
+}
+
+func TryAt(l *List, index int) (int, error) {
+	node, err := nodeAt(l, index)
+	if err != nil {
+		return 0, err
+	}
+
+	return node.Value, nil
+}
+
+func Remove(l *List, index int) bool {
+	return TryRemove(l, index) == nil
+}
+
+func TryRemove(l *List, index int) error {
+	node, err := nodeAt(l, index)
+	if err != nil {
+		return err
+	}
+
+	unlink(l, node)
+	return nil
+}
+
+func PopBack(l *List) (int, bool) {
+	result, err := TryPopBack(l)
+	return result, err == nil
+}
+
+func TryPopBack(l *List) (int, error) {
+	if Size(l) == 0 {
+		return 0, bounds.ErrEmpty
+	}
+
+	last := l.last
+	unlink(l, last)
+
+	return last.Value, nil
+}
+
+func PopFront(l *List) (int, bool) {
+	result, err := TryPopFront(l)
+	return result, err == nil
+}
+
+func TryPopFront(l *List) (int, error) {
+	if Size(l) == 0 {
+		return 0, bounds.ErrEmpty
+	}
+
+	first := l.first
+	unlink(l, first)
+
+	return first.Value, nil
+}
+
+func RemoveItem(l *List, value int) bool {
+	for cur := l.first; cur != nil; cur = cur.next {
+		if cur.Value == value {
+			unlink(l, cur)
+			return true
+		}
+	}
+
+	return false
+}
+
+func Front(l *List) (int, bool) {
+	result, err := TryFront(l)
+	return result, err == nil
+}
+
+func TryFront(l *List) (int, error) {
+	if Size(l) == 0 {
+		return 0, bounds.ErrEmpty
+	} else {
+		return l.first.Value, nil
+	}
+}
+
+func Back(l *List) (int, bool) {
+	result, err := TryBack(l)
+	return result, err == nil
+}
+
+func TryBack(l *List) (int, error) {
+	if Size(l) == 0 {
+		return 0, bounds.ErrEmpty
+	} else {
+		return l.last.Value, nil
+	}
+}
+
+func Reverse(l *List) {
+	if Size(l) < 2 {
+		return
+	}
+
+	for cur := l.first; cur != nil; cur = cur.prev {
+		cur.next, cur.prev = cur.prev, cur.next
+	}
+
+	l.first, l.last = l.last, l.first
+	l.mods++
+}
+
+func insertAfter(l *List, prev *Element, value int) *Element {
+	e := &Element{Value: value}
+	link(l, prev, e)
+
+	return e
+}
+
+// link puts e after prev, or at the front when prev is nil
+func link(l *List, prev *Element, e *Element) {
+	e.list = l
+
+	if prev == nil {
+		e.prev = nil
+		e.next = l.first
+		l.first = e
+	} else {
+		e.next = prev.next
+		e.prev = prev
+		prev.next = e
+	}
+
+	if e.next == nil {
+		l.last = e
+	} else {
+		e.next.prev = e
+	}
+
+	l.size++
+	l.mods++
+}
+
+func unlink(l *List, e *Element) {
+	if e.prev == nil {
+		l.first = e.next
+	} else {
+		e.prev.next = e.next
+	}
+
+	if e.next == nil {
+		l.last = e.prev
+	} else {
+		e.next.prev = e.prev
+	}
+
+	// the element can't be used as a handle anymore
+	e.next = nil
+	e.prev = nil
+	e.list = nil
+
+	l.size--
+	l.mods++
+}
@@ -0,0 +1,97 @@
+package dlist
+
+// Element is a handle to a value stored in a List.
+// It allows O(1) removals and insertions without walking the list
+type Element struct {
+	Value int
+	next  *Element
+	prev  *Element
+	list  *List
+}
+
+// Next returns the next element or nil if e is the last one
+func (e *Element) Next() *Element {
+	return e.next
+}
+
+// Prev returns the previous element or nil if e is the first one
+func (e *Element) Prev() *Element {
+	return e.prev
+}
+
+func FrontElement(l *List) *Element {
+	return l.first
+}
+
+func BackElement(l *List) *Element {
+	return l.last
+}
+
+func ElementAt(l *List, index int) (*Element, error) {
+	return nodeAt(l, index)
+}
+
+// FindElement returns the first element holding value or nil
+func FindElement(l *List, value int) *Element {
+	for cur := l.first; cur != nil; cur = cur.next {
+		if cur.Value == value {
+			return cur
+		}
+	}
+
+	return nil
+}
+
+// The functions below do nothing and return nil or false when the element
+// doesn't belong to l, e.g. when it has already been removed
+
+func InsertBefore(l *List, mark *Element, value int) *Element {
+	if mark.list != l {
+		return nil
+	}
+
+	return insertAfter(l, mark.prev, value)
+}
+
+func InsertAfter(l *List, mark *Element, value int) *Element {
+	if mark.list != l {
+		return nil
+	}
+
+	return insertAfter(l, mark, value)
+}
+
+func RemoveElement(l *List, e *Element) bool {
+	if e.list != l {
+		return false
+	}
+
+	unlink(l, e)
+	return true
+}
+
+func MoveToFront(l *List, e *Element) bool {
+	if e.list != l {
+		return false
+	}
+
+	if l.first != e {
+		unlink(l, e)
+		link(l, nil, e)
+	}
+
+	return true
+}
+
+func MoveToBack(l *List, e *Element) bool {
+	if e.list != l {
+		return false
+	}
+
+	if l.last != e {
+		unlink(l, e)
+		link(l, l.last, e)
+	}
+
+	return true
+}
@@ -0,0 +1,198 @@
+package dlist
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// requireLinks checks that both the next and the prev chains hold the expected values
+func requireLinks(t *testing.T, l *List, expected []int) {
+	forward := []int{}
+	for e := FrontElement(l); e != nil; e = e.Next() {
+		require.Equal(t, l, e.list)
+		forward = append(forward, e.Value)
+	}
+
+	backward := []int{}
+	for e := BackElement(l); e != nil; e = e.Prev() {
+		backward = append([]int{e.Value}, backward...)
+	}
+
+	require.Equal(t, expected, forward)
+	require.Equal(t, expected, backward)
+	require.Equal(t, len(expected), Size(l))
+
+	if len(expected) == 0 {
+		require.Nil(t, l.first)
+		require.Nil(t, l.last)
+	} else {
+		require.Nil(t, l.first.prev)
+		require.Nil(t, l.last.next)
+	}
+}
+
+func newList(values ...int) *List {
+	l := New()
+	for _, value := range values {
+		PushBack(l, value)
+	}
+	return l
+}
+
+func TestLinks(t *testing.T) {
+	l := New()
+	requireLinks(t, l, []int{})
+
+	PushBack(l, 2)
+	PushFront(l, 1)
+	PushBack(l, 4)
+	Insert(l, 2, 3)
+	requireLinks(t, l, []int{1, 2, 3, 4})
+
+	Remove(l, 1)
+	requireLinks(t, l, []int{1, 3, 4})
+
+	PopBack(l)
+	requireLinks(t, l, []int{1, 3})
+
+	Reverse(l)
+	requireLinks(t, l, []int{3, 1})
+
+	PopFront(l)
+	PopFront(l)
+	requireLinks(t, l, []int{})
+}
+
+func TestAtFromBothEnds(t *testing.T) {
+	l := newList(0, 1, 2, 3, 4, 5, 6)
+
+	for i := -7; i < 7; i++ {
+		value, ok := At(l, i)
+		require.Equal(t, true, ok)
+		require.Equal(t, (i+7)%7, value)
+	}
+}
+
+func TestElementAt(t *testing.T) {
+	l := newList(1, 2, 3)
+
+	e, err := ElementAt(l, -1)
+	require.Nil(t, err)
+	require.Equal(t, 3, e.Value)
+
+	_, err = ElementAt(l, 3)
+	require.Equal(t, &IndexError{Index: 3, Size: 3}, err)
+}
+
+func TestFindElement(t *testing.T) {
+	l := newList(1, 2, 3)
+
+	require.Nil(t, FindElement(l, 4))
+
+	e := FindElement(l, 2)
+	require.Equal(t, 2, e.Value)
+	require.Equal(t, 1, e.Prev().Value)
+	require.Equal(t, 3, e.Next().Value)
+}
+
+func TestInsertBeforeAfter(t *testing.T) {
+	l := newList(2)
+	mark := FrontElement(l)
+
+	InsertBefore(l, mark, 1)
+	InsertAfter(l, mark, 3)
+	requireLinks(t, l, []int{1, 2, 3})
+
+	e := InsertAfter(l, BackElement(l), 4)
+	require.Equal(t, e, BackElement(l))
+	e = InsertBefore(l, FrontElement(l), 0)
+	require.Equal(t, e, FrontElement(l))
+	requireLinks(t, l, []int{0, 1, 2, 3, 4})
+
+	// marks from another list are ignored
+	other := newList(5)
+	require.Nil(t, InsertAfter(l, FrontElement(other), 6))
+	require.Nil(t, InsertBefore(l, FrontElement(other), 6))
+	requireLinks(t, l, []int{0, 1, 2, 3, 4})
+}
+
+func TestRemoveElement(t *testing.T) {
+	l := newList(1, 2, 3)
+
+	middle := FrontElement(l).Next()
+	require.Equal(t, true, RemoveElement(l, middle))
+	requireLinks(t, l, []int{1, 3})
+
+	// removed elements can't be used anymore
+	require.Equal(t, false, RemoveElement(l, middle))
+	require.Nil(t, InsertAfter(l, middle, 5))
+	require.Nil(t, middle.Next())
+	require.Nil(t, middle.Prev())
+
+	require.Equal(t, true, RemoveElement(l, BackElement(l)))
+	require.Equal(t, true, RemoveElement(l, FrontElement(l)))
+	requireLinks(t, l, []int{})
+}
+
+func TestMoveToFront(t *testing.T) {
+	l := newList(1, 2, 3)
+
+	last := BackElement(l)
+	require.Equal(t, true, MoveToFront(l, last))
+	require.Equal(t, last, FrontElement(l))
+	requireLinks(t, l, []int{3, 1, 2})
+
+	require.Equal(t, true, MoveToFront(l, last))
+	requireLinks(t, l, []int{3, 1, 2})
+
+	require.Equal(t, true, MoveToFront(l, FrontElement(l).Next()))
+	requireLinks(t, l, []int{1, 3, 2})
+
+	require.Equal(t, false, MoveToFront(l, FrontElement(newList(4))))
+}
+
+func TestMoveToBack(t *testing.T) {
+	l := newList(1, 2, 3)
+
+	first := FrontElement(l)
+	require.Equal(t, true, MoveToBack(l, first))
+	require.Equal(t, first, BackElement(l))
+	requireLinks(t, l, []int{2, 3, 1})
+
+	require.Equal(t, true, MoveToBack(l, first))
+	requireLinks(t, l, []int{2, 3, 1})
+
+	require.Equal(t, true, MoveToBack(l, FrontElement(l).Next()))
+	requireLinks(t, l, []int{2, 1, 3})
+
+	require.Equal(t, false, MoveToBack(l, FrontElement(newList(4))))
+}
+
+func TestElementMethods(t *testing.T) {
+	l := New()
+	l.PushBack(2)
+
+	l.InsertBefore(l.FrontElement(), 1)
+	l.InsertAfter(l.BackElement(), 3)
+	l.MoveToFront(l.FindElement(3))
+	l.MoveToBack(l.FrontElement())
+
+	e, _ := l.ElementAt(0)
+	require.Equal(t, true, l.RemoveElement(e))
+	requireLinks(t, l, []int{2, 3})
+}
+
+func TestRemoveDuringWalk(t *testing.T) {
+	l := newList(1, 2, 3, 4, 5, 6)
+
+	// handles make it possible to filter the list in a single pass
+	for e := FrontElement(l); e != nil; {
+		next := e.Next()
+		if e.Value%2 == 0 {
+			RemoveElement(l, e)
+		}
+		e = next
+	}
+
+	requireLinks(t, l, []int{1, 3, 5})
+}
@@ -0,0 +1,54 @@
+package dlist
+
+import (
+	"iter"
+)
+
+// All yields index-value pairs from the front to the back.
+// Like the rest of the iterators, it panics with ErrConcurrentModification
+// if the loop body inserts, removes or moves elements
+func All(l *List) iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		mods := l.mods
+		i := 0
+		for cur := l.first; cur != nil; cur = cur.next {
+			if !yield(i, cur.Value) {
+				return
+			}
+			checkMods(l, mods)
+			i++
+		}
+	}
+}
+
+func Values(l *List) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for _, value := range All(l) {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward yields index-value pairs from the back to the front following the prev pointers
+func Backward(l *List) iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		mods := l.mods
+		i := Size(l) - 1
+		for cur := l.last; cur != nil; cur = cur.prev {
+			if !yield(i, cur.Value) {
+				return
+			}
+			checkMods(l, mods)
+			i--
+		}
+	}
+}
+
+// checkMods fails fast when the list was structurally modified since an iterator saw mods
+func checkMods(l *List, mods int) {
+	if l.mods != mods {
+		panic(ErrConcurrentModification)
+	}
+}
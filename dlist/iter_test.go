@@ -0,0 +1,141 @@
+package dlist
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"iter"
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	l := New()
+
+	for range All(l) {
+		t.Error("All() should not yield anything for an empty list")
+	}
+
+	PushBack(l, 10)
+	PushBack(l, 20)
+	PushBack(l, 30)
+
+	indices := []int{}
+	values := []int{}
+	for i, value := range All(l) {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	require.Equal(t, []int{0, 1, 2}, indices)
+	require.Equal(t, []int{10, 20, 30}, values)
+	require.Equal(t, map[int]int{0: 10, 1: 20, 2: 30}, maps.Collect(l.All()))
+}
+
+func TestValues(t *testing.T) {
+	l := New()
+	PushBack(l, 1)
+	PushBack(l, 2)
+	PushBack(l, 3)
+
+	require.Equal(t, []int{1, 2, 3}, slices.Collect(Values(l)))
+
+	visited := 0
+	for value := range l.Values() {
+		visited++
+		if value == 2 {
+			break
+		}
+	}
+	require.Equal(t, 2, visited)
+}
+
+func TestBackward(t *testing.T) {
+	l := New()
+	PushBack(l, 1)
+	PushBack(l, 2)
+	PushBack(l, 3)
+
+	indices := []int{}
+	values := []int{}
+	for i, value := range l.Backward() {
+		indices = append(indices, i)
+		values = append(values, value)
+	}
+
+	require.Equal(t, []int{2, 1, 0}, indices)
+	require.Equal(t, []int{3, 2, 1}, values)
+}
+
+func TestIterateReversed(t *testing.T) {
+	l := New()
+	PushBack(l, 1)
+	PushBack(l, 2)
+	PushBack(l, 3)
+	Reverse(l)
+
+	require.Equal(t, []int{3, 2, 1}, slices.Collect(Values(l)))
+
+	PushBack(l, 0)
+	require.Equal(t, []int{3, 2, 1, 0}, slices.Collect(Values(l)))
+}
+
+func TestConcurrentModification(t *testing.T) {
+	modifications := map[string]func(l *List){
+		"PushBack":  func(l *List) { PushBack(l, 1) },
+		"PushFront": func(l *List) { PushFront(l, 1) },
+		"Insert":    func(l *List) { Insert(l, 1, 1) },
+		"Remove":    func(l *List) { Remove(l, 1) },
+		"PopFront":  func(l *List) { PopFront(l) },
+		"Reverse":   func(l *List) { Reverse(l) },
+	}
+
+	iterators := map[string]func(l *List) iter.Seq2[int, int]{
+		"All":      All,
+		"Backward": Backward,
+		"Values": func(l *List) iter.Seq2[int, int] {
+			return func(yield func(int, int) bool) {
+				for value := range Values(l) {
+					if !yield(0, value) {
+						return
+					}
+				}
+			}
+		},
+	}
+
+	for name, modify := range modifications {
+		for iteratorName, iterate := range iterators {
+			t.Run(name+"/"+iteratorName, func(t *testing.T) {
+				l := New()
+				PushBack(l, 1)
+				PushBack(l, 2)
+				PushBack(l, 3)
+
+				defer func() {
+					err, _ := recover().(error)
+					require.Equal(t, true, errors.Is(err, ErrConcurrentModification))
+				}()
+
+				for range iterate(l) {
+					modify(l)
+				}
+
+				t.Error("the iterator should panic on a concurrent modification")
+			})
+		}
+	}
+}
+
+func TestModificationAfterBreak(t *testing.T) {
+	l := New()
+	PushBack(l, 1)
+	PushBack(l, 2)
+
+	for range All(l) {
+		break
+	}
+	PushBack(l, 3)
+
+	require.Equal(t, []int{1, 2, 3}, slices.Collect(Values(l)))
+}
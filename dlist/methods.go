@@ -0,0 +1,136 @@
+package dlist
+
+import (
+	"iter"
+)
+
+// Method forms of the package-level functions, so that a List can satisfy
+// interfaces and be passed around without importing the functions.
+
+func (l *List) Size() int {
+	return Size(l)
+}
+
+func (l *List) Empty() bool {
+	return Empty(l)
+}
+
+func (l *List) Insert(index int, value int) bool {
+	return Insert(l, index, value)
+}
+
+func (l *List) TryInsert(index int, value int) error {
+	return TryInsert(l, index, value)
+}
+
+func (l *List) PushFront(value int) {
+	PushFront(l, value)
+}
+
+func (l *List) PushBack(value int) {
+	PushBack(l, value)
+}
+
+func (l *List) At(index int) (int, bool) {
+	return At(l, index)
+}
+
+func (l *List) TryAt(index int) (int, error) {
+	return TryAt(l, index)
+}
+
+func (l *List) Remove(index int) bool {
+	return Remove(l, index)
+}
+
+func (l *List) TryRemove(index int) error {
+	return TryRemove(l, index)
+}
+
+func (l *List) PopBack() (int, bool) {
+	return PopBack(l)
+}
+
+func (l *List) TryPopBack() (int, error) {
+	return TryPopBack(l)
+}
+
+func (l *List) PopFront() (int, bool) {
+	return PopFront(l)
+}
+
+func (l *List) TryPopFront() (int, error) {
+	return TryPopFront(l)
+}
+
+func (l *List) RemoveItem(value int) bool {
+	return RemoveItem(l, value)
+}
+
+func (l *List) Front() (int, bool) {
+	return Front(l)
+}
+
+func (l *List) TryFront() (int, error) {
+	return TryFront(l)
+}
+
+func (l *List) Back() (int, bool) {
+	return Back(l)
+}
+
+func (l *List) TryBack() (int, error) {
+	return TryBack(l)
+}
+
+func (l *List) Reverse() {
+	Reverse(l)
+}
+
+func (l *List) All() iter.Seq2[int, int] {
+	return All(l)
+}
+
+func (l *List) Values() iter.Seq[int] {
+	return Values(l)
+}
+
+func (l *List) Backward() iter.Seq2[int, int] {
+	return Backward(l)
+}
+
+func (l *List) FrontElement() *Element {
+	return FrontElement(l)
+}
+
+func (l *List) BackElement() *Element {
+	return BackElement(l)
+}
+
+func (l *List) ElementAt(index int) (*Element, error) {
+	return ElementAt(l, index)
+}
+
+func (l *List) FindElement(value int) *Element {
+	return FindElement(l, value)
+}
+
+func (l *List) InsertBefore(mark *Element, value int) *Element {
+	return InsertBefore(l, mark, value)
+}
+
+func (l *List) InsertAfter(mark *Element, value int) *Element {
+	return InsertAfter(l, mark, value)
+}
+
+func (l *List) RemoveElement(e *Element) bool {
+	return RemoveElement(l, e)
+}
+
+func (l *List) MoveToFront(e *Element) bool {
+	return MoveToFront(l, e)
+}
+
+func (l *List) MoveToBack(e *Element) bool {
+	return MoveToBack(l, e)
+}
@@ -0,0 +1,122 @@
+package hashtable
+
+import (
+	"github.com/kirillrogovoy/computer-science/arrayGeneric"
+	"github.com/kirillrogovoy/computer-science/list"
+)
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+	hash  uint64
+}
+
+// chaining keeps the entries densely in a dynamic array. Since a list holds ints,
+// the buckets are lists of indices into that array
+type chaining[K comparable, V any] struct {
+	// buckets are created on the first put
+	buckets []*list.List
+	entries *arrayGeneric.Array[entry[K, V]]
+}
+
+func newChaining[K comparable, V any](capacity int) *chaining[K, V] {
+	return &chaining[K, V]{make([]*list.List, capacity), arrayGeneric.Create[entry[K, V]](0)}
+}
+
+func (c *chaining[K, V]) bucket(hash uint64) int {
+	return int(hash & uint64(len(c.buckets)-1))
+}
+
+// find returns the index of the entry with key or -1
+func (c *chaining[K, V]) find(key K, hash uint64) int {
+	bucket := c.buckets[c.bucket(hash)]
+	if bucket == nil {
+		return -1
+	}
+
+	for index := range list.Values(bucket) {
+		e := arrayGeneric.At(c.entries, index)
+		if e.hash == hash && e.key == key {
+			return index
+		}
+	}
+
+	return -1
+}
+
+func (c *chaining[K, V]) get(key K, hash uint64) (V, bool) {
+	index := c.find(key, hash)
+	if index < 0 {
+		var zero V
+		return zero, false
+	}
+
+	return arrayGeneric.At(c.entries, index).value, true
+}
+
+func (c *chaining[K, V]) put(key K, hash uint64, value V) bool {
+	if index := c.find(key, hash); index >= 0 {
+		arrayGeneric.Set(c.entries, index, entry[K, V]{key, value, hash})
+		return false
+	}
+
+	arrayGeneric.Push(c.entries, entry[K, V]{key, value, hash})
+	c.link(arrayGeneric.Size(c.entries)-1, hash)
+	return true
+}
+
+func (c *chaining[K, V]) link(index int, hash uint64) {
+	b := c.bucket(hash)
+	if c.buckets[b] == nil {
+		c.buckets[b] = list.New()
+	}
+
+	list.PushFront(c.buckets[b], index)
+}
+
+func (c *chaining[K, V]) delete(key K, hash uint64) bool {
+	index := c.find(key, hash)
+	if index < 0 {
+		return false
+	}
+
+	list.RemoveItem(c.buckets[c.bucket(hash)], index)
+
+	// fill the hole with the last entry and point its bucket at the new index
+	last := arrayGeneric.Size(c.entries) - 1
+	if index != last {
+		moved := arrayGeneric.At(c.entries, last)
+		arrayGeneric.Set(c.entries, index, moved)
+		list.FindCursor(c.buckets[c.bucket(moved.hash)], last).Set(index)
+	}
+
+	arrayGeneric.Pop(c.entries)
+	return true
+}
+
+func (c *chaining[K, V]) len() int {
+	return arrayGeneric.Size(c.entries)
+}
+
+func (c *chaining[K, V]) cap() int {
+	return len(c.buckets)
+}
+
+func (c *chaining[K, V]) used() int {
+	return c.len()
+}
+
+func (c *chaining[K, V]) rehash(capacity int) {
+	c.buckets = make([]*list.List, capacity)
+	for index, e := range arrayGeneric.All(c.entries) {
+		c.link(index, e.hash)
+	}
+}
+
+func (c *chaining[K, V]) all(yield func(K, V) bool) {
+	for _, e := range arrayGeneric.All(c.entries) {
+		if !yield(e.key, e.value) {
+			return
+		}
+	}
+}
@@ -0,0 +1,223 @@
+// Package hashtable is a hash map with a choice of collision resolution:
+// separate chaining with linked lists or open addressing with one of several
+// probe sequences. The load factor and the hash function can be tuned too
+package hashtable
+
+import (
+	"fmt"
+	"hash/maphash"
+
+	"github.com/kirillrogovoy/computer-science/arrayGeneric"
+	"github.com/kirillrogovoy/computer-science/bounds"
+)
+
+var ErrConcurrentModification = bounds.ErrConcurrentModification
+
+// Strategy selects how a Table resolves collisions
+type Strategy int
+
+const (
+	// Chaining keeps the entries which hash to the same bucket in a linked list
+	Chaining Strategy = iota
+	// LinearProbing tries the slots following the home one, one after another
+	LinearProbing
+	// QuadraticProbing jumps 1, 2, 3... slots further on each attempt,
+	// which breaks up the clusters linear probing builds
+	QuadraticProbing
+	// DoubleHashing jumps by a step which is a second hash of the key,
+	// so keys with the same home slot follow different sequences
+	DoubleHashing
+	// RobinHood is linear probing which lets the entry furthest from its home
+	// slot take the slot, keeping all probe sequences about equally short
+	RobinHood
+)
+
+var Strategies = []Strategy{Chaining, LinearProbing, QuadraticProbing, DoubleHashing, RobinHood}
+
+func (strategy Strategy) String() string {
+	switch strategy {
+	case Chaining:
+		return "chaining"
+	case LinearProbing:
+		return "linear"
+	case QuadraticProbing:
+		return "quadratic"
+	case DoubleHashing:
+		return "double"
+	case RobinHood:
+		return "robin-hood"
+	default:
+		return "unknown"
+	}
+}
+
+type Options[K comparable] struct {
+	Strategy Strategy
+	// MaxLoadFactor is the ratio of items to buckets or slots above which the table grows.
+	// It must be in (0, 1) for open addressing. 0 picks 1 for Chaining and 0.75 for the rest
+	MaxLoadFactor float64
+	// Hash replaces the default seeded hash, e.g. with one tuned for a known key distribution.
+	// Only the low bits pick the bucket, so it should spread keys over them
+	Hash func(key K) uint64
+}
+
+// store is the part of a collision resolution strategy a Table needs
+type store[K comparable, V any] interface {
+	get(key K, hash uint64) (V, bool)
+	// put returns true if the key is new
+	put(key K, hash uint64, value V) bool
+	delete(key K, hash uint64) bool
+	len() int
+	// cap is the number of buckets or slots
+	cap() int
+	// used is what counts towards the load factor: the items and, for open addressing, the tombstones
+	used() int
+	// rehash moves everything into capacity new buckets or slots
+	rehash(capacity int)
+	all(yield func(K, V) bool)
+}
+
+type Table[K comparable, V any] struct {
+	store   store[K, V]
+	hash    func(key K) uint64
+	maxLoad float64
+	// policy keeps the capacity a power of 2 so that hashes are mapped to buckets with a bit mask
+	policy arrayGeneric.GrowthPolicy
+	// mods counts structural modifications so that iterators can fail fast
+	mods int
+}
+
+func New[K comparable, V any](strategy Strategy) *Table[K, V] {
+	return NewWithOptions[K, V](Options[K]{Strategy: strategy})
+}
+
+func NewWithOptions[K comparable, V any](options Options[K]) *Table[K, V] {
+	maxLoad := options.MaxLoadFactor
+	if maxLoad == 0 {
+		maxLoad = 0.75
+		if options.Strategy == Chaining {
+			maxLoad = 1
+		}
+	}
+
+	if maxLoad < 0 || (options.Strategy != Chaining && maxLoad >= 1) {
+		panic(fmt.Sprintf("MaxLoadFactor must be in (0, 1) for open addressing, got %v", maxLoad))
+	}
+
+	hash := options.Hash
+	if hash == nil {
+		seed := maphash.MakeSeed()
+		hash = func(key K) uint64 {
+			return maphash.Comparable(seed, key)
+		}
+	}
+
+	// shrink when a quarter full relative to the max load, so that a shrunk table still has room to grow
+	policy := arrayGeneric.DefaultGrowthPolicy()
+	policy.ShrinkThreshold = maxLoad / 4
+
+	capacity := policy.Capacity(0)
+
+	var s store[K, V]
+	switch options.Strategy {
+	case Chaining:
+		s = newChaining[K, V](capacity)
+	case LinearProbing, QuadraticProbing, DoubleHashing:
+		s = newProbing[K, V](options.Strategy, capacity)
+	case RobinHood:
+		s = newRobinHood[K, V](capacity)
+	default:
+		panic(fmt.Sprintf("Unknown hash table strategy %d", options.Strategy))
+	}
+
+	return &Table[K, V]{s, hash, maxLoad, policy, 0}
+}
+
+func Len[K comparable, V any](t *Table[K, V]) int {
+	return t.store.len()
+}
+
+func Empty[K comparable, V any](t *Table[K, V]) bool {
+	return Len(t) == 0
+}
+
+// Cap returns the number of buckets or slots
+func Cap[K comparable, V any](t *Table[K, V]) int {
+	return t.store.cap()
+}
+
+// LoadFactor returns the ratio of items to buckets or slots
+func LoadFactor[K comparable, V any](t *Table[K, V]) float64 {
+	return float64(Len(t)) / float64(Cap(t))
+}
+
+func Get[K comparable, V any](t *Table[K, V], key K) (V, bool) {
+	return t.store.get(key, t.hash(key))
+}
+
+func Contains[K comparable, V any](t *Table[K, V], key K) bool {
+	_, ok := Get(t, key)
+	return ok
+}
+
+// Put sets the value of key and tells if the key is new
+func Put[K comparable, V any](t *Table[K, V], key K, value V) bool {
+	hash := t.hash(key)
+
+	// make room first, so that probe sequences always end at an empty slot.
+	// Updating a key takes no room, so it never rehashes under a running iterator
+	if float64(t.store.used()+1) > t.maxLoad*float64(Cap(t)) {
+		if _, exists := t.store.get(key, hash); !exists {
+			grow(t)
+		}
+	}
+
+	added := t.store.put(key, hash, value)
+	if added {
+		t.mods++
+	}
+
+	return added
+}
+
+// Delete removes key and tells if it was there
+func Delete[K comparable, V any](t *Table[K, V], key K) bool {
+	deleted := t.store.delete(key, t.hash(key))
+	if deleted {
+		t.mods++
+		resize(t, t.policy.Shrink(Len(t), Cap(t)))
+	}
+
+	return deleted
+}
+
+// grow doubles the capacity, unless the items alone take at most half of the max load
+// and the rest is tombstones. Then rehashing at the same capacity is enough to get rid of them
+func grow[K comparable, V any](t *Table[K, V]) {
+	if float64(Len(t)+1) > t.maxLoad*float64(Cap(t))/2 {
+		resize(t, t.policy.Grow(Cap(t)))
+	} else {
+		rehash(t, Cap(t))
+	}
+}
+
+func resize[K comparable, V any](t *Table[K, V], newCapacity int) {
+	if newCapacity == Cap(t) {
+		return
+	}
+
+	if float64(Len(t)) > t.maxLoad*float64(newCapacity) {
+		panic(fmt.Sprintf(
+			"Tried to resize a hash table with %d items to the capacity %d which is too small",
+			Len(t),
+			newCapacity,
+		))
+	}
+
+	rehash(t, newCapacity)
+}
+
+func rehash[K comparable, V any](t *Table[K, V], capacity int) {
+	t.store.rehash(capacity)
+	t.mods++
+}
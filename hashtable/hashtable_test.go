@@ -0,0 +1,281 @@
+package hashtable
+
+import (
+	"fmt"
+	"maps"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// requireTable compares the table with a map and checks the load factor
+func requireTable(t *testing.T, table *Table[int, string], model map[int]string) {
+	require.Equal(t, len(model), Len(table))
+	require.Equal(t, model, maps.Collect(All(table)))
+	require.LessOrEqual(t, LoadFactor(table), table.maxLoad)
+
+	for key, value := range model {
+		actual, ok := Get(table, key)
+		require.Equal(t, true, ok)
+		require.Equal(t, value, actual)
+	}
+}
+
+func TestRandomOperations(t *testing.T) {
+	for _, strategy := range Strategies {
+		t.Run(strategy.String(), func(t *testing.T) {
+			random := rand.New(rand.NewSource(42))
+			table := New[int, string](strategy)
+			model := map[int]string{}
+
+			for i := 0; i < 5000; i++ {
+				key := random.Intn(300)
+				_, exists := model[key]
+
+				if random.Intn(3) > 0 {
+					value := fmt.Sprint(i)
+					require.Equal(t, !exists, Put(table, key, value))
+					model[key] = value
+				} else {
+					require.Equal(t, exists, Delete(table, key))
+					delete(model, key)
+				}
+
+				_, inModel := model[key]
+				require.Equal(t, inModel, Contains(table, key))
+				require.Equal(t, len(model), Len(table))
+			}
+
+			requireTable(t, table, model)
+		})
+	}
+}
+
+func TestCollisions(t *testing.T) {
+	// every key hashes to the same bucket
+	for _, strategy := range Strategies {
+		t.Run(strategy.String(), func(t *testing.T) {
+			table := NewWithOptions[int, string](Options[int]{
+				Strategy: strategy,
+				Hash:     func(key int) uint64 { return 0 },
+			})
+			model := map[int]string{}
+
+			for key := 0; key < 100; key++ {
+				table.Put(key, fmt.Sprint(key))
+				model[key] = fmt.Sprint(key)
+			}
+
+			for key := 0; key < 100; key += 3 {
+				table.Delete(key)
+				delete(model, key)
+			}
+
+			requireTable(t, table, model)
+			require.Equal(t, false, table.Contains(0))
+			require.Equal(t, true, table.Contains(1))
+		})
+	}
+}
+
+func TestResize(t *testing.T) {
+	for _, strategy := range Strategies {
+		t.Run(strategy.String(), func(t *testing.T) {
+			table := NewWithOptions[int, string](Options[int]{Strategy: strategy, MaxLoadFactor: 0.5})
+			require.Equal(t, 16, table.Cap())
+
+			for key := 0; key < 1000; key++ {
+				table.Put(key, "")
+				require.LessOrEqual(t, table.LoadFactor(), 0.5)
+			}
+
+			require.Equal(t, 2048, table.Cap())
+
+			for key := 0; key < 1000; key++ {
+				table.Delete(key)
+			}
+
+			require.Equal(t, 16, table.Cap())
+			require.Equal(t, true, table.Empty())
+		})
+	}
+}
+
+func TestTombstones(t *testing.T) {
+	for _, strategy := range []Strategy{LinearProbing, QuadraticProbing, DoubleHashing} {
+		t.Run(strategy.String(), func(t *testing.T) {
+			table := New[int, int](strategy)
+			p := table.store.(*probing[int, int])
+
+			// keep 4 keys while churning through many more, which leaves tombstones behind
+			for key := 0; key < 10000; key++ {
+				table.Put(key, key)
+				if key >= 4 {
+					table.Delete(key - 4)
+				}
+
+				require.LessOrEqual(t, float64(p.used()), 0.75*float64(table.Cap()))
+			}
+
+			// rehashing gets rid of the tombstones instead of growing the table
+			require.Equal(t, 16, table.Cap())
+			require.Equal(t, 4, table.Len())
+
+			// a put reuses the tombstone on its way. A fresh table, so that the put
+			// doesn't run into the load factor and rehash the tombstones away instead
+			table = New[int, int](strategy)
+			p = table.store.(*probing[int, int])
+			for key := range 4 {
+				table.Put(key, key)
+			}
+			table.Delete(3)
+			require.Equal(t, 1, p.tombstones)
+			table.Put(3, 0)
+			require.Equal(t, 0, p.tombstones)
+		})
+	}
+}
+
+func TestUpdateAtLoadThreshold(t *testing.T) {
+	for _, strategy := range Strategies {
+		t.Run(strategy.String(), func(t *testing.T) {
+			table := New[int, int](strategy)
+
+			// fill the table up to the point where one more key makes it grow
+			for key := 0; float64(table.store.used()+1) <= table.maxLoad*float64(table.Cap()); key++ {
+				table.Put(key, key)
+			}
+			capacity := table.Cap()
+
+			require.NotPanics(t, func() {
+				for key, value := range table.All() {
+					require.Equal(t, false, table.Put(key, value*10))
+				}
+			})
+			require.Equal(t, capacity, table.Cap())
+
+			for key, value := range table.All() {
+				require.Equal(t, key*10, value)
+			}
+
+			// a new key still grows the table
+			table.Put(-1, -1)
+			require.Greater(t, table.Cap(), capacity)
+		})
+	}
+}
+
+func TestRobinHoodInvariant(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	table := NewWithOptions[int, int](Options[int]{Strategy: RobinHood, MaxLoadFactor: 0.9})
+	r := table.store.(*robinHood[int, int])
+
+	for i := 0; i < 3000; i++ {
+		key := random.Intn(500)
+		if random.Intn(3) > 0 {
+			table.Put(key, key)
+		} else {
+			table.Delete(key)
+		}
+	}
+
+	// an entry is at most one slot further from home than the entry before it,
+	// otherwise it would have taken that slot
+	for pos, s := range r.slots {
+		if s.state != full {
+			continue
+		}
+
+		distance := r.distance(pos, s.hash)
+		if distance == 0 {
+			continue
+		}
+
+		prevPos := (pos - 1) & r.mask()
+		prev := r.slots[prevPos]
+		require.Equal(t, full, prev.state)
+		require.GreaterOrEqual(t, r.distance(prevPos, prev.hash), distance-1)
+	}
+}
+
+func TestIterators(t *testing.T) {
+	for _, strategy := range Strategies {
+		t.Run(strategy.String(), func(t *testing.T) {
+			table := New[string, int](strategy)
+			table.Put("a", 1)
+			table.Put("b", 2)
+			table.Put("c", 3)
+
+			keys := map[string]bool{}
+			for key := range table.Keys() {
+				keys[key] = true
+			}
+			require.Equal(t, map[string]bool{"a": true, "b": true, "c": true}, keys)
+
+			sum := 0
+			for value := range table.Values() {
+				sum += value
+			}
+			require.Equal(t, 6, sum)
+
+			// updating values while iterating is fine
+			for key, value := range table.All() {
+				table.Put(key, value*10)
+			}
+			v, _ := table.Get("b")
+			require.Equal(t, 20, v)
+
+			require.Panics(t, func() {
+				for key := range table.All() {
+					table.Delete(key)
+				}
+			})
+		})
+	}
+}
+
+func TestOptions(t *testing.T) {
+	require.Equal(t, 1.0, New[int, int](Chaining).maxLoad)
+	require.Equal(t, 0.75, New[int, int](RobinHood).maxLoad)
+
+	// chaining may have more items than buckets
+	table := NewWithOptions[int, int](Options[int]{Strategy: Chaining, MaxLoadFactor: 4})
+	for key := 0; key < 64; key++ {
+		table.Put(key, key)
+	}
+	require.Equal(t, 16, table.Cap())
+
+	require.Panics(t, func() { NewWithOptions[int, int](Options[int]{Strategy: LinearProbing, MaxLoadFactor: 1}) })
+	require.Panics(t, func() { NewWithOptions[int, int](Options[int]{Strategy: -1}) })
+}
+
+func BenchmarkPutGet(b *testing.B) {
+	keys := rand.New(rand.NewSource(1)).Perm(100000)
+
+	for _, strategy := range Strategies {
+		b.Run(strategy.String(), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				table := New[int, int](strategy)
+				for _, key := range keys {
+					table.Put(key, key)
+				}
+				for _, key := range keys {
+					table.Get(key)
+				}
+			}
+		})
+	}
+
+	b.Run("builtin", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			m := map[int]int{}
+			for _, key := range keys {
+				m[key] = key
+			}
+			for _, key := range keys {
+				_ = m[key]
+			}
+		}
+	})
+}
@@ -0,0 +1,47 @@
+package hashtable
+
+import (
+	"iter"
+)
+
+// All yields key-value pairs in no particular order.
+// Like the rest of the iterators, it panics with ErrConcurrentModification
+// if the loop body adds or deletes keys. Updating the value of a key is fine
+func All[K comparable, V any](t *Table[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		mods := t.mods
+		t.store.all(func(key K, value V) bool {
+			if !yield(key, value) {
+				return false
+			}
+			checkMods(t, mods)
+			return true
+		})
+	}
+}
+
+func Keys[K comparable, V any](t *Table[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range All(t) {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+func Values[K comparable, V any](t *Table[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range All(t) {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+func checkMods[K comparable, V any](t *Table[K, V], mods int) {
+	if t.mods != mods {
+		panic(ErrConcurrentModification)
+	}
+}
@@ -0,0 +1,51 @@
+package hashtable
+
+import (
+	"iter"
+)
+
+// Method forms of the package-level functions
+
+func (t *Table[K, V]) Len() int {
+	return Len(t)
+}
+
+func (t *Table[K, V]) Empty() bool {
+	return Empty(t)
+}
+
+func (t *Table[K, V]) Cap() int {
+	return Cap(t)
+}
+
+func (t *Table[K, V]) LoadFactor() float64 {
+	return LoadFactor(t)
+}
+
+func (t *Table[K, V]) Get(key K) (V, bool) {
+	return Get(t, key)
+}
+
+func (t *Table[K, V]) Contains(key K) bool {
+	return Contains(t, key)
+}
+
+func (t *Table[K, V]) Put(key K, value V) bool {
+	return Put(t, key, value)
+}
+
+func (t *Table[K, V]) Delete(key K) bool {
+	return Delete(t, key)
+}
+
+func (t *Table[K, V]) All() iter.Seq2[K, V] {
+	return All(t)
+}
+
+func (t *Table[K, V]) Keys() iter.Seq[K] {
+	return Keys(t)
+}
+
+func (t *Table[K, V]) Values() iter.Seq[V] {
+	return Values(t)
+}
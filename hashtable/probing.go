@@ -0,0 +1,156 @@
+package hashtable
+
+type slotState uint8
+
+const (
+	empty slotState = iota
+	full
+	// deleted is a tombstone: lookups have to probe past it, but puts may reuse it
+	deleted
+)
+
+type slot[K comparable, V any] struct {
+	key   K
+	value V
+	hash  uint64
+	state slotState
+}
+
+// probing is open addressing with tombstones: a deleted slot can't become empty
+// since it may be in the middle of another key's probe sequence
+type probing[K comparable, V any] struct {
+	strategy   Strategy
+	slots      []slot[K, V]
+	size       int
+	tombstones int
+}
+
+func newProbing[K comparable, V any](strategy Strategy, capacity int) *probing[K, V] {
+	return &probing[K, V]{strategy, make([]slot[K, V], capacity), 0, 0}
+}
+
+// position returns the slot to try on the attempt i.
+// With a power of 2 capacity, every sequence visits all slots
+func (p *probing[K, V]) position(hash uint64, i int) int {
+	mask := uint64(len(p.slots) - 1)
+
+	switch p.strategy {
+	case QuadraticProbing:
+		// triangular numbers: 0, 1, 3, 6, 10...
+		return int((hash + uint64(i*(i+1)/2)) & mask)
+	case DoubleHashing:
+		// the step is a Fibonacci hash of the first hash, odd to be coprime with the capacity
+		step := (hash*0x9E3779B97F4A7C15)>>32 | 1
+		return int((hash + uint64(i)*step) & mask)
+	default:
+		return int((hash + uint64(i)) & mask)
+	}
+}
+
+// find returns the slot holding key or -1
+func (p *probing[K, V]) find(key K, hash uint64) int {
+	for i := 0; i < len(p.slots); i++ {
+		pos := p.position(hash, i)
+		s := &p.slots[pos]
+
+		if s.state == empty {
+			return -1
+		}
+
+		if s.state == full && s.hash == hash && s.key == key {
+			return pos
+		}
+	}
+
+	return -1
+}
+
+func (p *probing[K, V]) get(key K, hash uint64) (V, bool) {
+	pos := p.find(key, hash)
+	if pos < 0 {
+		var zero V
+		return zero, false
+	}
+
+	return p.slots[pos].value, true
+}
+
+func (p *probing[K, V]) put(key K, hash uint64, value V) bool {
+	tombstone := -1
+	for i := 0; i < len(p.slots); i++ {
+		pos := p.position(hash, i)
+		s := &p.slots[pos]
+
+		switch s.state {
+		case full:
+			if s.hash == hash && s.key == key {
+				s.value = value
+				return false
+			}
+		case deleted:
+			if tombstone < 0 {
+				tombstone = pos
+			}
+		case empty:
+			// the key isn't in the table, reuse the first tombstone on the way if any
+			if tombstone < 0 {
+				tombstone = pos
+			} else {
+				p.tombstones--
+			}
+
+			p.slots[tombstone] = slot[K, V]{key, value, hash, full}
+			p.size++
+			return true
+		}
+	}
+
+	// the table is so full of tombstones there's no empty slot, which the load factor prevents
+	panic("Probed all slots of a hash table without finding an empty one")
+}
+
+func (p *probing[K, V]) delete(key K, hash uint64) bool {
+	pos := p.find(key, hash)
+	if pos < 0 {
+		return false
+	}
+
+	// let the garbage collector have what the key and the value refer to
+	p.slots[pos] = slot[K, V]{state: deleted}
+	p.size--
+	p.tombstones++
+	return true
+}
+
+func (p *probing[K, V]) len() int {
+	return p.size
+}
+
+func (p *probing[K, V]) cap() int {
+	return len(p.slots)
+}
+
+func (p *probing[K, V]) used() int {
+	return p.size + p.tombstones
+}
+
+func (p *probing[K, V]) rehash(capacity int) {
+	old := p.slots
+	p.slots = make([]slot[K, V], capacity)
+	p.size = 0
+	p.tombstones = 0
+
+	for _, s := range old {
+		if s.state == full {
+			p.put(s.key, s.hash, s.value)
+		}
+	}
+}
+
+func (p *probing[K, V]) all(yield func(K, V) bool) {
+	for _, s := range p.slots {
+		if s.state == full && !yield(s.key, s.value) {
+			return
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package hashtable
+
+// robinHood is linear probing where an entry being put takes the slot of any entry
+// which is closer to its home slot, and that entry moves on instead.
+// Lookups can stop as soon as they meet an entry closer to home than the key would be,
+// and deletes shift the following entries back, so there are no tombstones
+type robinHood[K comparable, V any] struct {
+	slots []slot[K, V]
+	size  int
+}
+
+func newRobinHood[K comparable, V any](capacity int) *robinHood[K, V] {
+	return &robinHood[K, V]{make([]slot[K, V], capacity), 0}
+}
+
+func (r *robinHood[K, V]) mask() int {
+	return len(r.slots) - 1
+}
+
+// distance returns how far the entry with hash sitting at pos is from its home slot
+func (r *robinHood[K, V]) distance(pos int, hash uint64) int {
+	return (pos - int(hash&uint64(r.mask()))) & r.mask()
+}
+
+// find returns the slot holding key or -1
+func (r *robinHood[K, V]) find(key K, hash uint64) int {
+	pos := int(hash & uint64(r.mask()))
+	for i := 0; i < len(r.slots); i++ {
+		s := &r.slots[pos]
+
+		if s.state == empty || r.distance(pos, s.hash) < i {
+			return -1
+		}
+
+		if s.hash == hash && s.key == key {
+			return pos
+		}
+
+		pos = (pos + 1) & r.mask()
+	}
+
+	return -1
+}
+
+func (r *robinHood[K, V]) get(key K, hash uint64) (V, bool) {
+	pos := r.find(key, hash)
+	if pos < 0 {
+		var zero V
+		return zero, false
+	}
+
+	return r.slots[pos].value, true
+}
+
+func (r *robinHood[K, V]) put(key K, hash uint64, value V) bool {
+	if pos := r.find(key, hash); pos >= 0 {
+		r.slots[pos].value = value
+		return false
+	}
+
+	cur := slot[K, V]{key, value, hash, full}
+	pos := int(hash & uint64(r.mask()))
+	for i := 0; ; i++ {
+		s := &r.slots[pos]
+
+		if s.state == empty {
+			*s = cur
+			r.size++
+			return true
+		}
+
+		// take from the rich: the entry closer to its home gives up the slot
+		if distance := r.distance(pos, s.hash); distance < i {
+			cur, *s = *s, cur
+			i = distance
+		}
+
+		pos = (pos + 1) & r.mask()
+	}
+}
+
+func (r *robinHood[K, V]) delete(key K, hash uint64) bool {
+	pos := r.find(key, hash)
+	if pos < 0 {
+		return false
+	}
+
+	// shift the following entries one slot back until one is empty or already at home
+	for {
+		next := (pos + 1) & r.mask()
+		s := r.slots[next]
+		if s.state == empty || r.distance(next, s.hash) == 0 {
+			break
+		}
+
+		r.slots[pos] = s
+		pos = next
+	}
+
+	r.slots[pos] = slot[K, V]{}
+	r.size--
+	return true
+}
+
+func (r *robinHood[K, V]) len() int {
+	return r.size
+}
+
+func (r *robinHood[K, V]) cap() int {
+	return len(r.slots)
+}
+
+func (r *robinHood[K, V]) used() int {
+	return r.size
+}
+
+func (r *robinHood[K, V]) rehash(capacity int) {
+	old := r.slots
+	r.slots = make([]slot[K, V], capacity)
+	r.size = 0
+
+	for _, s := range old {
+		if s.state == full {
+			r.put(s.key, s.hash, s.value)
+		}
+	}
+}
+
+func (r *robinHood[K, V]) all(yield func(K, V) bool) {
+	for _, s := range r.slots {
+		if s.state == full && !yield(s.key, s.value) {
+			return
+		}
+	}
+}
@@ -0,0 +1,306 @@
+// Package binomial is a binomial heap: a list of binomial trees of distinct
+// degrees, much like the bits of the number of items. Meld, PopTop and
+// DecreaseKey are O(log n) and Push is O(1) amortised, since it links trees
+// only as long as there's a carry, like incrementing a binary counter
+package binomial
+
+import (
+	"cmp"
+
+	"github.com/kirillrogovoy/computer-science/bounds"
+	"github.com/kirillrogovoy/computer-science/heap"
+	"github.com/kirillrogovoy/computer-science/heap/internal/owner"
+)
+
+var (
+	ErrEmpty    = bounds.ErrEmpty
+	ErrWrongWay = heap.ErrWrongWay
+	ErrRemoved  = heap.ErrRemoved
+	ErrForeign  = heap.ErrForeign
+	ErrSelfMeld = heap.ErrSelfMeld
+)
+
+// Handle is returned by Insert and stays valid until the item is popped.
+// DecreaseKey moves items between the nodes, so handles are kept apart from them
+type Handle[T any] struct {
+	item  T
+	node  *node[T]
+	owner *owner.Cell[Heap[T]]
+}
+
+func (n *Handle[T]) Item() T {
+	return n.item
+}
+
+type node[T any] struct {
+	handle *Handle[T]
+	parent *node[T]
+	// child is the child with the greatest degree, the rest are chained through sibling
+	child   *node[T]
+	sibling *node[T]
+	degree  int
+}
+
+type Heap[T any] struct {
+	// head is the root list ordered by increasing degree
+	head    *node[T]
+	size    int
+	compare func(a, b T) int
+	// owner is shared by the handles of the heap so that Meld doesn't have to visit them
+	owner *owner.Cell[Heap[T]]
+}
+
+// NewMin returns a heap which pops the smallest item first
+func NewMin[T cmp.Ordered]() *Heap[T] {
+	return NewFunc(heap.MinFirst[T])
+}
+
+// NewMax returns a heap which pops the greatest item first
+func NewMax[T cmp.Ordered]() *Heap[T] {
+	return NewFunc(heap.MaxFirst[T])
+}
+
+// NewFunc returns a heap which pops first the item compare considers the smallest
+func NewFunc[T any](compare func(a, b T) int) *Heap[T] {
+	h := &Heap[T]{nil, 0, compare, nil}
+	h.owner = owner.New(h)
+	return h
+}
+
+func Len[T any](h *Heap[T]) int {
+	return h.size
+}
+
+func Empty[T any](h *Heap[T]) bool {
+	return h.size == 0
+}
+
+func Push[T any](h *Heap[T], item T) {
+	Insert(h, item)
+}
+
+// Insert pushes item and returns a handle for DecreaseKey
+func Insert[T any](h *Heap[T], item T) *Handle[T] {
+	handle := &Handle[T]{item: item, owner: h.owner}
+	handle.node = &node[T]{handle: handle}
+
+	// the new tree of degree 0 is the carry. Adding it to the lowest roots
+	// links a tree for every trailing 1 bit of the size and stops at the first 0,
+	// and there are n such links over n pushes
+	carry := handle.node
+	rest := h.head
+	for rest != nil && rest.degree == carry.degree {
+		next := rest.sibling
+		if less(h, rest, carry) {
+			link(carry, rest)
+			carry = rest
+		} else {
+			link(rest, carry)
+		}
+
+		rest = next
+	}
+
+	carry.sibling = rest
+	h.head = carry
+	h.size++
+
+	return handle
+}
+
+func Peek[T any](h *Heap[T]) T {
+	item, err := TryPeek(h)
+	if err != nil {
+		panic(err)
+	}
+
+	return item
+}
+
+func TryPeek[T any](h *Heap[T]) (T, error) {
+	if Empty(h) {
+		var zero T
+		return zero, bounds.ErrEmpty
+	}
+
+	_, top := findTop(h)
+	return top.handle.item, nil
+}
+
+func PopTop[T any](h *Heap[T]) T {
+	item, err := TryPopTop(h)
+	if err != nil {
+		panic(err)
+	}
+
+	return item
+}
+
+func TryPopTop[T any](h *Heap[T]) (T, error) {
+	if Empty(h) {
+		var zero T
+		return zero, bounds.ErrEmpty
+	}
+
+	prev, top := findTop(h)
+	if prev == nil {
+		h.head = top.sibling
+	} else {
+		prev.sibling = top.sibling
+	}
+
+	// the children are ordered by decreasing degree, reversing them makes a root list
+	var children *node[T]
+	for child := top.child; child != nil; {
+		next := child.sibling
+		child.parent = nil
+		child.sibling = children
+		children = child
+		child = next
+	}
+
+	union(h, children)
+	h.size--
+
+	handle := top.handle
+	handle.node = nil
+
+	return handle.item, nil
+}
+
+// DecreaseKey moves n towards the top by giving it an item which compare
+// doesn't put after the current one
+func DecreaseKey[T any](h *Heap[T], n *Handle[T], item T) {
+	if err := TryDecreaseKey(h, n, item); err != nil {
+		panic(err)
+	}
+}
+
+func TryDecreaseKey[T any](h *Heap[T], n *Handle[T], item T) error {
+	if n.owner.Heap() != h {
+		return ErrForeign
+	}
+
+	if n.node == nil {
+		return ErrRemoved
+	}
+
+	if h.compare(item, n.item) > 0 {
+		return ErrWrongWay
+	}
+
+	n.item = item
+
+	// bubble the handle up by swapping it with the parent's one
+	cur := n.node
+	for cur.parent != nil && less(h, cur, cur.parent) {
+		parent := cur.parent
+		cur.handle, parent.handle = parent.handle, cur.handle
+		cur.handle.node = cur
+		parent.handle.node = parent
+		cur = parent
+	}
+
+	return nil
+}
+
+// Meld moves all items of other into h in O(log n), leaving other empty.
+// Handles of other's items keep working with h
+func Meld[T any](h *Heap[T], other *Heap[T]) {
+	if h == other {
+		panic(ErrSelfMeld)
+	}
+
+	union(h, other.head)
+	h.size += other.size
+	owner.Meld(other.owner, h.owner)
+
+	other.head = nil
+	other.size = 0
+	other.owner = owner.New(other)
+}
+
+// findTop finds the root with the top item and the root before it
+func findTop[T any](h *Heap[T]) (*node[T], *node[T]) {
+	var prev, top, topPrev *node[T]
+	for cur := h.head; cur != nil; cur = cur.sibling {
+		if top == nil || less(h, cur, top) {
+			top, topPrev = cur, prev
+		}
+		prev = cur
+	}
+
+	return topPrev, top
+}
+
+// union adds the root list other to h linking the trees of equal degree,
+// which works like adding two binary numbers
+func union[T any](h *Heap[T], other *node[T]) {
+	head := mergeRoots(h.head, other)
+	if head == nil {
+		h.head = nil
+		return
+	}
+
+	var prev *node[T]
+	cur := head
+	next := cur.sibling
+	for next != nil {
+		// three trees of the same degree in a row: link the last two on the next step
+		if cur.degree != next.degree || (next.sibling != nil && next.sibling.degree == cur.degree) {
+			prev = cur
+			cur = next
+		} else if !less(h, next, cur) {
+			cur.sibling = next.sibling
+			link(next, cur)
+		} else {
+			if prev == nil {
+				head = next
+			} else {
+				prev.sibling = next
+			}
+
+			link(cur, next)
+			cur = next
+		}
+
+		next = cur.sibling
+	}
+
+	h.head = head
+}
+
+// mergeRoots merges two root lists into one ordered by degree
+func mergeRoots[T any](a, b *node[T]) *node[T] {
+	var head, tail *node[T]
+	for a != nil || b != nil {
+		var next *node[T]
+		if b == nil || (a != nil && a.degree <= b.degree) {
+			next, a = a, a.sibling
+		} else {
+			next, b = b, b.sibling
+		}
+
+		if tail == nil {
+			head = next
+		} else {
+			tail.sibling = next
+		}
+
+		tail = next
+	}
+
+	return head
+}
+
+// link makes the root child, which has the same degree as parent, the first child of parent
+func link[T any](child, parent *node[T]) {
+	child.parent = parent
+	child.sibling = parent.child
+	parent.child = child
+	parent.degree++
+}
+
+func less[T any](h *Heap[T], a, b *node[T]) bool {
+	return h.compare(a.handle.item, b.handle.item) < 0
+}
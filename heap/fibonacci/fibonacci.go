@@ -0,0 +1,347 @@
+// Package fibonacci is a Fibonacci heap: a lazy collection of heap-ordered trees
+// which are only consolidated on PopTop. Push, Meld and DecreaseKey are O(1)
+// amortised and PopTop is O(log n) amortised
+package fibonacci
+
+import (
+	"cmp"
+
+	"github.com/kirillrogovoy/computer-science/bounds"
+	"github.com/kirillrogovoy/computer-science/heap"
+	"github.com/kirillrogovoy/computer-science/heap/internal/owner"
+)
+
+var (
+	ErrEmpty    = bounds.ErrEmpty
+	ErrWrongWay = heap.ErrWrongWay
+	ErrRemoved  = heap.ErrRemoved
+	ErrForeign  = heap.ErrForeign
+	ErrSelfMeld = heap.ErrSelfMeld
+)
+
+// Handle is a node of the heap returned by Insert. It stays valid until the item is popped
+type Handle[T any] struct {
+	item   T
+	parent *Handle[T]
+	// child is any of the children, they are in a circular doubly linked list
+	child *Handle[T]
+	// left and right link the node with its siblings, or the other roots
+	left  *Handle[T]
+	right *Handle[T]
+	// degree is the number of children
+	degree int
+	// marked is set when the node has lost a child since it became a child itself
+	marked bool
+	owner  *owner.Cell[Heap[T]]
+}
+
+func (n *Handle[T]) Item() T {
+	return n.item
+}
+
+type Heap[T any] struct {
+	// top is the root with the top item, the other roots are its siblings
+	top     *Handle[T]
+	size    int
+	compare func(a, b T) int
+	// owner is shared by the handles of the heap so that Meld can hand them over in O(1)
+	owner *owner.Cell[Heap[T]]
+	// roots and degrees are reused by consolidate so that PopTop doesn't allocate
+	roots   []*Handle[T]
+	degrees []*Handle[T]
+}
+
+// NewMin returns a heap which pops the smallest item first
+func NewMin[T cmp.Ordered]() *Heap[T] {
+	return NewFunc(heap.MinFirst[T])
+}
+
+// NewMax returns a heap which pops the greatest item first
+func NewMax[T cmp.Ordered]() *Heap[T] {
+	return NewFunc(heap.MaxFirst[T])
+}
+
+// NewFunc returns a heap which pops first the item compare considers the smallest
+func NewFunc[T any](compare func(a, b T) int) *Heap[T] {
+	h := &Heap[T]{compare: compare}
+	h.owner = owner.New(h)
+	return h
+}
+
+func Len[T any](h *Heap[T]) int {
+	return h.size
+}
+
+func Empty[T any](h *Heap[T]) bool {
+	return h.size == 0
+}
+
+func Push[T any](h *Heap[T], item T) {
+	Insert(h, item)
+}
+
+// Insert pushes item and returns a handle for DecreaseKey
+func Insert[T any](h *Heap[T], item T) *Handle[T] {
+	n := &Handle[T]{item: item, owner: h.owner}
+	n.left, n.right = n, n
+
+	addRoot(h, n)
+	h.size++
+
+	return n
+}
+
+func Peek[T any](h *Heap[T]) T {
+	item, err := TryPeek(h)
+	if err != nil {
+		panic(err)
+	}
+
+	return item
+}
+
+func TryPeek[T any](h *Heap[T]) (T, error) {
+	if Empty(h) {
+		var zero T
+		return zero, bounds.ErrEmpty
+	}
+
+	return h.top.item, nil
+}
+
+func PopTop[T any](h *Heap[T]) T {
+	item, err := TryPopTop(h)
+	if err != nil {
+		panic(err)
+	}
+
+	return item
+}
+
+func TryPopTop[T any](h *Heap[T]) (T, error) {
+	if Empty(h) {
+		var zero T
+		return zero, bounds.ErrEmpty
+	}
+
+	top := h.top
+
+	// the children of the top become roots
+	if top.child != nil {
+		child := top.child
+		for {
+			child.parent = nil
+			child = child.right
+			if child == top.child {
+				break
+			}
+		}
+
+		splice(top, top.child)
+		top.child = nil
+	}
+
+	if top.right == top {
+		h.top = nil
+	} else {
+		h.top = top.right
+		unlink(top)
+		consolidate(h)
+	}
+
+	h.size--
+
+	// a popped handle is not in any list
+	top.left, top.right = nil, nil
+
+	return top.item, nil
+}
+
+// DecreaseKey moves n towards the top by giving it an item which compare
+// doesn't put after the current one
+func DecreaseKey[T any](h *Heap[T], n *Handle[T], item T) {
+	if err := TryDecreaseKey(h, n, item); err != nil {
+		panic(err)
+	}
+}
+
+func TryDecreaseKey[T any](h *Heap[T], n *Handle[T], item T) error {
+	if n.owner.Heap() != h {
+		return ErrForeign
+	}
+
+	if n.left == nil {
+		return ErrRemoved
+	}
+
+	if h.compare(item, n.item) > 0 {
+		return ErrWrongWay
+	}
+
+	n.item = item
+
+	parent := n.parent
+	if parent != nil && less(h, n, parent) {
+		cut(h, n)
+		cascadingCut(h, parent)
+	}
+
+	if less(h, n, h.top) {
+		h.top = n
+	}
+
+	return nil
+}
+
+// Meld moves all items of other into h in O(1), leaving other empty.
+// Handles of other's items keep working with h
+func Meld[T any](h *Heap[T], other *Heap[T]) {
+	if h == other {
+		panic(ErrSelfMeld)
+	}
+
+	if other.top != nil {
+		addRoot(h, other.top)
+	}
+
+	h.size += other.size
+	owner.Meld(other.owner, h.owner)
+
+	other.top = nil
+	other.size = 0
+	other.owner = owner.New(other)
+}
+
+// addRoot splices the list of roots containing n into the root list and updates the top
+func addRoot[T any](h *Heap[T], n *Handle[T]) {
+	if h.top == nil {
+		h.top = n
+		return
+	}
+
+	splice(h.top, n)
+	if less(h, n, h.top) {
+		h.top = n
+	}
+}
+
+// consolidate links the roots of equal degree until all degrees are distinct
+func consolidate[T any](h *Heap[T]) {
+	roots := h.roots[:0]
+	for n := h.top; ; {
+		roots = append(roots, n)
+		n = n.right
+		if n == h.top {
+			break
+		}
+	}
+
+	degrees := h.degrees[:0]
+	for _, n := range roots {
+		degree := n.degree
+		for {
+			for degree >= len(degrees) {
+				degrees = append(degrees, nil)
+			}
+
+			other := degrees[degree]
+			if other == nil {
+				break
+			}
+
+			if less(h, other, n) {
+				n, other = other, n
+			}
+
+			link(other, n)
+			degrees[degree] = nil
+			degree++
+		}
+
+		degrees[degree] = n
+	}
+
+	h.top = nil
+	for _, n := range degrees {
+		if n != nil && (h.top == nil || less(h, n, h.top)) {
+			h.top = n
+		}
+	}
+
+	// don't keep popped nodes reachable through the scratch space
+	clear(roots)
+	clear(degrees)
+	h.roots, h.degrees = roots, degrees[:0]
+}
+
+// link makes the root child a child of the root parent
+func link[T any](child, parent *Handle[T]) {
+	unlink(child)
+	child.parent = parent
+	child.marked = false
+
+	if parent.child == nil {
+		parent.child = child
+	} else {
+		splice(parent.child, child)
+	}
+
+	parent.degree++
+}
+
+// cut moves n from its parent's children to the roots
+func cut[T any](h *Heap[T], n *Handle[T]) {
+	parent := n.parent
+	if n.right == n {
+		parent.child = nil
+	} else {
+		if parent.child == n {
+			parent.child = n.right
+		}
+
+		unlink(n)
+	}
+
+	parent.degree--
+	n.parent = nil
+	n.marked = false
+
+	splice(h.top, n)
+}
+
+// cascadingCut cuts the ancestors which have lost their second child,
+// which keeps the trees wide enough for the O(log n) bound on degrees
+func cascadingCut[T any](h *Heap[T], n *Handle[T]) {
+	for n.parent != nil {
+		if !n.marked {
+			n.marked = true
+			return
+		}
+
+		parent := n.parent
+		cut(h, n)
+		n = parent
+	}
+}
+
+// splice joins two circular lists into one
+func splice[T any](a, b *Handle[T]) {
+	aRight := a.right
+	bLeft := b.left
+
+	a.right = b
+	b.left = a
+	bLeft.right = aRight
+	aRight.left = bLeft
+}
+
+// unlink takes n out of its circular list leaving it alone in its own one
+func unlink[T any](n *Handle[T]) {
+	n.left.right = n.right
+	n.right.left = n.left
+	n.left, n.right = n, n
+}
+
+func less[T any](h *Heap[T], a, b *Handle[T]) bool {
+	return h.compare(a.item, b.item) < 0
+}
@@ -0,0 +1,47 @@
+package fibonacci
+
+// Method forms of the package-level functions
+
+func (h *Heap[T]) Len() int {
+	return Len(h)
+}
+
+func (h *Heap[T]) Empty() bool {
+	return Empty(h)
+}
+
+func (h *Heap[T]) Push(item T) {
+	Push(h, item)
+}
+
+func (h *Heap[T]) Insert(item T) *Handle[T] {
+	return Insert(h, item)
+}
+
+func (h *Heap[T]) Peek() T {
+	return Peek(h)
+}
+
+func (h *Heap[T]) TryPeek() (T, error) {
+	return TryPeek(h)
+}
+
+func (h *Heap[T]) PopTop() T {
+	return PopTop(h)
+}
+
+func (h *Heap[T]) TryPopTop() (T, error) {
+	return TryPopTop(h)
+}
+
+func (h *Heap[T]) DecreaseKey(n *Handle[T], item T) {
+	DecreaseKey(h, n, item)
+}
+
+func (h *Heap[T]) TryDecreaseKey(n *Handle[T], item T) error {
+	return TryDecreaseKey(h, n, item)
+}
+
+func (h *Heap[T]) Meld(other *Heap[T]) {
+	Meld(h, other)
+}
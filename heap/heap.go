@@ -0,0 +1,191 @@
+package heap
+
+import (
+	"cmp"
+
+	"github.com/kirillrogovoy/computer-science/arrayGeneric"
+	"github.com/kirillrogovoy/computer-science/bounds"
+)
+
+var (
+	ErrIndexOutOfRange = bounds.ErrIndexOutOfRange
+	ErrEmpty           = bounds.ErrEmpty
+)
+
+type IndexError = bounds.IndexError
+
+// Heap is a binary heap kept in a dynamic array: the children of the item at i
+// are at 2i+1 and 2i+2. The top is the item which compare puts first
+type Heap[T any] struct {
+	arr     *arrayGeneric.Array[T]
+	compare func(a, b T) int
+}
+
+// NewMin returns a heap which pops the smallest item first
+func NewMin[T cmp.Ordered]() *Heap[T] {
+	return NewFunc(MinFirst[T])
+}
+
+// NewMax returns a heap which pops the greatest item first
+func NewMax[T cmp.Ordered]() *Heap[T] {
+	return NewFunc(MaxFirst[T])
+}
+
+// NewFunc returns a heap which pops first the item compare considers the smallest
+func NewFunc[T any](compare func(a, b T) int) *Heap[T] {
+	return &Heap[T]{arrayGeneric.Create[T](0), compare}
+}
+
+// Heapify turns arr into a heap in O(n). The heap keeps its items in arr,
+// so arr shouldn't be modified directly afterwards
+func Heapify[T any](arr *arrayGeneric.Array[T], compare func(a, b T) int) *Heap[T] {
+	h := &Heap[T]{arr, compare}
+
+	// the leaves are heaps already, sift down the rest starting from the last parent
+	for i := Len(h)/2 - 1; i >= 0; i-- {
+		down(h, i)
+	}
+
+	return h
+}
+
+func Len[T any](h *Heap[T]) int {
+	return arrayGeneric.Size(h.arr)
+}
+
+func Empty[T any](h *Heap[T]) bool {
+	return Len(h) == 0
+}
+
+func Push[T any](h *Heap[T], item T) {
+	arrayGeneric.Push(h.arr, item)
+	up(h, Len(h)-1)
+}
+
+func Peek[T any](h *Heap[T]) T {
+	item, err := TryPeek(h)
+	if err != nil {
+		panic(err)
+	}
+
+	return item
+}
+
+func TryPeek[T any](h *Heap[T]) (T, error) {
+	if Empty(h) {
+		var zero T
+		return zero, bounds.ErrEmpty
+	}
+
+	return arrayGeneric.At(h.arr, 0), nil
+}
+
+func PopTop[T any](h *Heap[T]) T {
+	item, err := TryPopTop(h)
+	if err != nil {
+		panic(err)
+	}
+
+	return item
+}
+
+func TryPopTop[T any](h *Heap[T]) (T, error) {
+	if Empty(h) {
+		var zero T
+		return zero, bounds.ErrEmpty
+	}
+
+	return TryRemove(h, 0)
+}
+
+// Fix restores the order after the item at index was changed in place, e.g. its priority
+func Fix[T any](h *Heap[T], index int) {
+	index, err := bounds.Normalize(index, Len(h))
+	if err != nil {
+		panic(err)
+	}
+
+	if !down(h, index) {
+		up(h, index)
+	}
+}
+
+func Remove[T any](h *Heap[T], index int) T {
+	item, err := TryRemove(h, index)
+	if err != nil {
+		panic(err)
+	}
+
+	return item
+}
+
+// TryRemove takes out the item at index, which is the position in the underlying array
+func TryRemove[T any](h *Heap[T], index int) (T, error) {
+	index, err := bounds.Normalize(index, Len(h))
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	// put the last item in place of the removed one and let it find its place
+	last := Len(h) - 1
+	if index != last {
+		swap(h, index, last)
+	}
+
+	item := arrayGeneric.Pop(h.arr)
+
+	if index != last {
+		Fix(h, index)
+	}
+
+	return item, nil
+}
+
+func up[T any](h *Heap[T], index int) {
+	for index > 0 {
+		parent := (index - 1) / 2
+		if !less(h, index, parent) {
+			return
+		}
+
+		swap(h, index, parent)
+		index = parent
+	}
+}
+
+// down sifts the item at index down and tells if it moved
+func down[T any](h *Heap[T], index int) bool {
+	start := index
+	size := Len(h)
+
+	for {
+		child := 2*index + 1
+		if child >= size {
+			break
+		}
+
+		if child+1 < size && less(h, child+1, child) {
+			child++
+		}
+
+		if !less(h, child, index) {
+			break
+		}
+
+		swap(h, index, child)
+		index = child
+	}
+
+	return index != start
+}
+
+func less[T any](h *Heap[T], i, j int) bool {
+	return h.compare(arrayGeneric.At(h.arr, i), arrayGeneric.At(h.arr, j)) < 0
+}
+
+func swap[T any](h *Heap[T], i, j int) {
+	item := arrayGeneric.At(h.arr, i)
+	arrayGeneric.Set(h.arr, i, arrayGeneric.At(h.arr, j))
+	arrayGeneric.Set(h.arr, j, item)
+}
@@ -0,0 +1,190 @@
+package heap
+
+import (
+	"cmp"
+	"github.com/kirillrogovoy/computer-science/array"
+	arrayAny "github.com/kirillrogovoy/computer-science/arrayAny"
+	"github.com/kirillrogovoy/computer-science/arrayGeneric"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// requireHeap checks that no child is put before its parent
+func requireHeap[T any](t *testing.T, h *Heap[T]) {
+	for i := 1; i < Len(h); i++ {
+		parent := (i - 1) / 2
+		require.Equal(t, false, less(h, i, parent), "item %d is put before its parent %d", i, parent)
+	}
+}
+
+func popAll[T any](h *Heap[T]) []T {
+	result := []T{}
+	for !Empty(h) {
+		result = append(result, PopTop(h))
+	}
+	return result
+}
+
+func TestMinHeap(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+	h := NewMin[int]()
+
+	items := []int{}
+	for i := 0; i < 500; i++ {
+		item := random.Intn(100)
+		items = append(items, item)
+		Push(h, item)
+		requireHeap(t, h)
+	}
+
+	require.Equal(t, 500, Len(h))
+	require.Equal(t, slices.Min(items), Peek(h))
+
+	slices.Sort(items)
+	require.Equal(t, items, popAll(h))
+}
+
+func TestMaxHeap(t *testing.T) {
+	h := NewMax[string]()
+	for _, item := range []string{"b", "d", "a", "c"} {
+		h.Push(item)
+	}
+
+	require.Equal(t, "d", h.Peek())
+	require.Equal(t, []string{"d", "c", "b", "a"}, popAll(h))
+}
+
+func TestEmpty(t *testing.T) {
+	h := NewMin[int]()
+	require.Equal(t, true, Empty(h))
+
+	_, err := TryPeek(h)
+	require.Equal(t, ErrEmpty, err)
+	_, err = TryPopTop(h)
+	require.Equal(t, ErrEmpty, err)
+	_, err = TryRemove(h, 0)
+	require.Equal(t, &IndexError{Index: 0, Size: 0}, err)
+}
+
+func TestPopTopPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("PopTop() should panic when the heap is empty")
+		}
+	}()
+
+	PopTop(NewMin[int]())
+}
+
+func TestHeapify(t *testing.T) {
+	arr := array.Create(16)
+	for _, item := range []int{5, 3, 8, 1, 9, 2, 7} {
+		array.Push(arr, item)
+	}
+
+	h := Heapify(arr, cmp.Compare[int])
+	requireHeap(t, h)
+	require.Equal(t, 7, Len(h))
+
+	// the heap keeps its items in the very same array
+	require.Equal(t, 1, array.At(arr, 0))
+
+	Push(h, 0)
+	require.Equal(t, 8, array.Size(arr))
+	require.Equal(t, []int{0, 1, 2, 3, 5, 7, 8, 9}, popAll(h))
+	require.Equal(t, 0, array.Size(arr))
+}
+
+type task struct {
+	name     string
+	priority int
+}
+
+func TestHeapifyAny(t *testing.T) {
+	arr := arrayAny.Create(16)
+	arrayAny.Push(arr, task{"write", 2})
+	arrayAny.Push(arr, task{"test", 1})
+	arrayAny.Push(arr, task{"ship", 3})
+
+	byPriority := func(a, b any) int {
+		return cmp.Compare(a.(task).priority, b.(task).priority)
+	}
+
+	h := Heapify(arr, byPriority)
+	require.Equal(t, "test", PopTop(h).(task).name)
+	require.Equal(t, "write", PopTop(h).(task).name)
+	require.Equal(t, "ship", PopTop(h).(task).name)
+}
+
+func TestFix(t *testing.T) {
+	h := NewFunc(func(a, b *task) int {
+		return cmp.Compare(a.priority, b.priority)
+	})
+
+	tasks := []*task{{"a", 10}, {"b", 20}, {"c", 30}, {"d", 40}, {"e", 50}}
+	for _, tk := range tasks {
+		Push(h, tk)
+	}
+
+	// the last one becomes the most urgent
+	last := Len(h) - 1
+	arrayGeneric.At(h.arr, last).priority = 0
+	Fix(h, last)
+	requireHeap(t, h)
+	require.Equal(t, "e", Peek(h).name)
+
+	// and the top becomes the least urgent
+	Peek(h).priority = 100
+	h.Fix(0)
+	requireHeap(t, h)
+	require.Equal(t, "a", Peek(h).name)
+
+	names := []string{}
+	for !h.Empty() {
+		names = append(names, h.PopTop().name)
+	}
+	require.Equal(t, []string{"a", "b", "c", "d", "e"}, names)
+}
+
+func TestFixPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Fix() should panic when the index is out of bounds")
+		}
+	}()
+
+	Fix(NewMin[int](), 0)
+}
+
+func TestRemove(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 50; i++ {
+		h := NewMin[int]()
+		items := []int{}
+		for j := 0; j < 30; j++ {
+			item := random.Intn(50)
+			items = append(items, item)
+			Push(h, item)
+		}
+
+		index := random.Intn(Len(h))
+		removed := Remove(h, index)
+		requireHeap(t, h)
+
+		items = slices.Delete(items, slices.Index(items, removed), slices.Index(items, removed)+1)
+		slices.Sort(items)
+		require.Equal(t, items, popAll(h))
+	}
+
+	h := NewMin[int]()
+	h.Push(1)
+	h.Push(2)
+	require.Equal(t, 2, h.Remove(-1))
+	require.Equal(t, 1, h.Len())
+
+	_, err := h.TryRemove(1)
+	require.Equal(t, &IndexError{Index: 1, Size: 1}, err)
+}
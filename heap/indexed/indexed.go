@@ -0,0 +1,261 @@
+// Package indexed is a binary heap whose entries are addressed by an ID, so the
+// priority of an entry already in the heap can be changed in O(log n).
+// That's what Dijkstra's and Prim's algorithms need
+package indexed
+
+import (
+	"cmp"
+	"errors"
+
+	"github.com/kirillrogovoy/computer-science/arrayGeneric"
+	"github.com/kirillrogovoy/computer-science/bounds"
+	"github.com/kirillrogovoy/computer-science/heap"
+)
+
+var (
+	ErrEmpty     = bounds.ErrEmpty
+	ErrDuplicate = errors.New("id is already in the heap")
+	ErrNotFound  = errors.New("id is not in the heap")
+	ErrWrongWay  = heap.ErrWrongWay
+)
+
+type entry[K comparable, P any] struct {
+	id       K
+	priority P
+}
+
+// Heap keeps its entries in a dynamic array like heap.Heap does and remembers
+// the position of every ID in that array, updating it on every swap
+type Heap[K comparable, P any] struct {
+	arr       *arrayGeneric.Array[entry[K, P]]
+	positions map[K]int
+	compare   func(a, b P) int
+}
+
+// NewMin returns a heap which pops the entry with the smallest priority first
+func NewMin[K comparable, P cmp.Ordered]() *Heap[K, P] {
+	return NewFunc[K](heap.MinFirst[P])
+}
+
+// NewMax returns a heap which pops the entry with the greatest priority first
+func NewMax[K comparable, P cmp.Ordered]() *Heap[K, P] {
+	return NewFunc[K](heap.MaxFirst[P])
+}
+
+// NewFunc returns a heap which pops first the entry whose priority compare considers the smallest
+func NewFunc[K comparable, P any](compare func(a, b P) int) *Heap[K, P] {
+	return &Heap[K, P]{arrayGeneric.Create[entry[K, P]](0), map[K]int{}, compare}
+}
+
+func Len[K comparable, P any](h *Heap[K, P]) int {
+	return arrayGeneric.Size(h.arr)
+}
+
+func Empty[K comparable, P any](h *Heap[K, P]) bool {
+	return Len(h) == 0
+}
+
+func Contains[K comparable, P any](h *Heap[K, P], id K) bool {
+	_, ok := h.positions[id]
+	return ok
+}
+
+// Priority returns the current priority of id
+func Priority[K comparable, P any](h *Heap[K, P], id K) (P, bool) {
+	index, ok := h.positions[id]
+	if !ok {
+		var zero P
+		return zero, false
+	}
+
+	return arrayGeneric.At(h.arr, index).priority, true
+}
+
+func Push[K comparable, P any](h *Heap[K, P], id K, priority P) {
+	if err := TryPush(h, id, priority); err != nil {
+		panic(err)
+	}
+}
+
+func TryPush[K comparable, P any](h *Heap[K, P], id K, priority P) error {
+	if Contains(h, id) {
+		return ErrDuplicate
+	}
+
+	arrayGeneric.Push(h.arr, entry[K, P]{id, priority})
+	index := Len(h) - 1
+	h.positions[id] = index
+	up(h, index)
+
+	return nil
+}
+
+func Peek[K comparable, P any](h *Heap[K, P]) (K, P) {
+	id, priority, err := TryPeek(h)
+	if err != nil {
+		panic(err)
+	}
+
+	return id, priority
+}
+
+func TryPeek[K comparable, P any](h *Heap[K, P]) (K, P, error) {
+	if Empty(h) {
+		var id K
+		var priority P
+		return id, priority, bounds.ErrEmpty
+	}
+
+	top := arrayGeneric.At(h.arr, 0)
+	return top.id, top.priority, nil
+}
+
+func PopTop[K comparable, P any](h *Heap[K, P]) (K, P) {
+	id, priority, err := TryPopTop(h)
+	if err != nil {
+		panic(err)
+	}
+
+	return id, priority
+}
+
+func TryPopTop[K comparable, P any](h *Heap[K, P]) (K, P, error) {
+	if Empty(h) {
+		var id K
+		var priority P
+		return id, priority, bounds.ErrEmpty
+	}
+
+	top := removeAt(h, 0)
+	return top.id, top.priority, nil
+}
+
+// DecreaseKey moves id towards the top by giving it a priority which compare
+// doesn't put after the current one. For a max heap, that's a greater number
+func DecreaseKey[K comparable, P any](h *Heap[K, P], id K, priority P) {
+	if err := TryDecreaseKey(h, id, priority); err != nil {
+		panic(err)
+	}
+}
+
+func TryDecreaseKey[K comparable, P any](h *Heap[K, P], id K, priority P) error {
+	index, ok := h.positions[id]
+	if !ok {
+		return ErrNotFound
+	}
+
+	if h.compare(priority, arrayGeneric.At(h.arr, index).priority) > 0 {
+		return ErrWrongWay
+	}
+
+	arrayGeneric.Set(h.arr, index, entry[K, P]{id, priority})
+	up(h, index)
+
+	return nil
+}
+
+// IncreaseKey moves id away from the top by giving it a priority which compare
+// doesn't put before the current one
+func IncreaseKey[K comparable, P any](h *Heap[K, P], id K, priority P) {
+	if err := TryIncreaseKey(h, id, priority); err != nil {
+		panic(err)
+	}
+}
+
+func TryIncreaseKey[K comparable, P any](h *Heap[K, P], id K, priority P) error {
+	index, ok := h.positions[id]
+	if !ok {
+		return ErrNotFound
+	}
+
+	if h.compare(priority, arrayGeneric.At(h.arr, index).priority) < 0 {
+		return ErrWrongWay
+	}
+
+	arrayGeneric.Set(h.arr, index, entry[K, P]{id, priority})
+	down(h, index)
+
+	return nil
+}
+
+// Delete takes id out of the heap wherever it is and returns its priority
+func Delete[K comparable, P any](h *Heap[K, P], id K) (P, bool) {
+	index, ok := h.positions[id]
+	if !ok {
+		var zero P
+		return zero, false
+	}
+
+	return removeAt(h, index).priority, true
+}
+
+func removeAt[K comparable, P any](h *Heap[K, P], index int) entry[K, P] {
+	// put the last entry in place of the removed one and let it find its place
+	last := Len(h) - 1
+	if index != last {
+		swap(h, index, last)
+	}
+
+	removed := arrayGeneric.Pop(h.arr)
+	delete(h.positions, removed.id)
+
+	if index != last && !down(h, index) {
+		up(h, index)
+	}
+
+	return removed
+}
+
+func up[K comparable, P any](h *Heap[K, P], index int) {
+	for index > 0 {
+		parent := (index - 1) / 2
+		if !less(h, index, parent) {
+			return
+		}
+
+		swap(h, index, parent)
+		index = parent
+	}
+}
+
+// down sifts the entry at index down and tells if it moved
+func down[K comparable, P any](h *Heap[K, P], index int) bool {
+	start := index
+	size := Len(h)
+
+	for {
+		child := 2*index + 1
+		if child >= size {
+			break
+		}
+
+		if child+1 < size && less(h, child+1, child) {
+			child++
+		}
+
+		if !less(h, child, index) {
+			break
+		}
+
+		swap(h, index, child)
+		index = child
+	}
+
+	return index != start
+}
+
+func less[K comparable, P any](h *Heap[K, P], i, j int) bool {
+	return h.compare(arrayGeneric.At(h.arr, i).priority, arrayGeneric.At(h.arr, j).priority) < 0
+}
+
+// swap exchanges two entries and keeps positions in sync with the array
+func swap[K comparable, P any](h *Heap[K, P], i, j int) {
+	a := arrayGeneric.At(h.arr, i)
+	b := arrayGeneric.At(h.arr, j)
+
+	arrayGeneric.Set(h.arr, i, b)
+	arrayGeneric.Set(h.arr, j, a)
+
+	h.positions[a.id] = j
+	h.positions[b.id] = i
+}
@@ -0,0 +1,188 @@
+package indexed
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// requireHeap checks the heap order and that every ID knows its position
+func requireHeap[K comparable, P any](t *testing.T, h *Heap[K, P]) {
+	require.Equal(t, Len(h), len(h.positions))
+
+	for i := 0; i < Len(h); i++ {
+		if i > 0 {
+			parent := (i - 1) / 2
+			require.Equal(t, false, less(h, i, parent), "entry %d is put before its parent %d", i, parent)
+		}
+
+		id := h.arr.At(i).id
+		require.Equal(t, i, h.positions[id])
+	}
+}
+
+func TestPushPop(t *testing.T) {
+	h := NewMin[string, int]()
+	h.Push("c", 3)
+	h.Push("a", 1)
+	h.Push("b", 2)
+	requireHeap(t, h)
+
+	require.Equal(t, ErrDuplicate, h.TryPush("a", 0))
+	require.Equal(t, 3, h.Len())
+
+	id, priority := h.Peek()
+	require.Equal(t, "a", id)
+	require.Equal(t, 1, priority)
+
+	for _, expected := range []string{"a", "b", "c"} {
+		id, _ := PopTop(h)
+		require.Equal(t, expected, id)
+		require.Equal(t, false, Contains(h, id))
+	}
+
+	_, _, err := TryPeek(h)
+	require.Equal(t, ErrEmpty, err)
+	_, _, err = TryPopTop(h)
+	require.Equal(t, ErrEmpty, err)
+}
+
+func TestDecreaseKey(t *testing.T) {
+	h := NewMin[int, int]()
+	for id := 0; id < 10; id++ {
+		Push(h, id, 100+id)
+	}
+
+	DecreaseKey(h, 7, 5)
+	requireHeap(t, h)
+	id, _ := Peek(h)
+	require.Equal(t, 7, id)
+
+	require.Equal(t, ErrWrongWay, TryDecreaseKey(h, 3, 200))
+	require.Equal(t, ErrNotFound, TryDecreaseKey(h, 42, 0))
+
+	priority, ok := Priority(h, 3)
+	require.Equal(t, true, ok)
+	require.Equal(t, 103, priority)
+}
+
+func TestIncreaseKey(t *testing.T) {
+	h := NewMax[string, float64]()
+	h.Push("low", 1)
+	h.Push("high", 10)
+	h.Push("mid", 5)
+
+	// in a max heap, increasing the key means moving away from the top
+	h.IncreaseKey("high", 0)
+	requireHeap(t, h)
+	id, _ := h.Peek()
+	require.Equal(t, "mid", id)
+
+	require.Equal(t, ErrWrongWay, h.TryIncreaseKey("low", 20))
+	require.Equal(t, ErrNotFound, h.TryIncreaseKey("none", 0))
+}
+
+func TestDelete(t *testing.T) {
+	h := NewMin[int, int]()
+	for id := 0; id < 20; id++ {
+		Push(h, id, (id*7)%20)
+	}
+
+	priority, ok := Delete(h, 4)
+	require.Equal(t, true, ok)
+	require.Equal(t, 8, priority)
+	require.Equal(t, false, Contains(h, 4))
+	requireHeap(t, h)
+
+	_, ok = h.Delete(4)
+	require.Equal(t, false, ok)
+
+	// the ID can be pushed again once it's gone
+	h.Push(4, -1)
+	id, _ := h.Peek()
+	require.Equal(t, 4, id)
+}
+
+func TestRandomOperations(t *testing.T) {
+	random := rand.New(rand.NewSource(7))
+	h := NewMin[int, int]()
+	model := map[int]int{}
+
+	for i := 0; i < 2000; i++ {
+		id := random.Intn(50)
+		priority := random.Intn(1000)
+		current, exists := model[id]
+
+		switch random.Intn(4) {
+		case 0:
+			if exists {
+				require.Equal(t, ErrDuplicate, TryPush(h, id, priority))
+			} else {
+				Push(h, id, priority)
+				model[id] = priority
+			}
+		case 1:
+			if exists && priority <= current {
+				DecreaseKey(h, id, priority)
+				model[id] = priority
+			} else if exists && priority >= current {
+				IncreaseKey(h, id, priority)
+				model[id] = priority
+			}
+		case 2:
+			deleted, ok := Delete(h, id)
+			require.Equal(t, exists, ok)
+			if exists {
+				require.Equal(t, current, deleted)
+				delete(model, id)
+			}
+		case 3:
+			if Empty(h) {
+				continue
+			}
+
+			id, priority := PopTop(h)
+			require.Equal(t, model[id], priority)
+			for _, other := range model {
+				require.LessOrEqual(t, priority, other)
+			}
+			delete(model, id)
+		}
+
+		requireHeap(t, h)
+		require.Equal(t, len(model), Len(h))
+	}
+}
+
+func TestDijkstra(t *testing.T) {
+	type edge struct{ to, weight int }
+	graph := map[int][]edge{
+		0: {{1, 4}, {2, 1}},
+		1: {{3, 1}},
+		2: {{1, 2}, {3, 5}},
+		3: {{4, 3}},
+		4: {},
+	}
+
+	distances := map[int]int{}
+	h := NewMin[int, int]()
+	h.Push(0, 0)
+	for node := 1; node < len(graph); node++ {
+		h.Push(node, math.MaxInt)
+	}
+
+	for !h.Empty() {
+		node, distance := h.PopTop()
+		distances[node] = distance
+
+		for _, e := range graph[node] {
+			if current, ok := h.Priority(e.to); ok && distance+e.weight < current {
+				h.DecreaseKey(e.to, distance+e.weight)
+			}
+		}
+	}
+
+	require.Equal(t, map[int]int{0: 0, 1: 3, 2: 1, 3: 4, 4: 7}, distances)
+}
@@ -0,0 +1,63 @@
+package indexed
+
+// Method forms of the package-level functions
+
+func (h *Heap[K, P]) Len() int {
+	return Len(h)
+}
+
+func (h *Heap[K, P]) Empty() bool {
+	return Empty(h)
+}
+
+func (h *Heap[K, P]) Contains(id K) bool {
+	return Contains(h, id)
+}
+
+func (h *Heap[K, P]) Priority(id K) (P, bool) {
+	return Priority(h, id)
+}
+
+func (h *Heap[K, P]) Push(id K, priority P) {
+	Push(h, id, priority)
+}
+
+func (h *Heap[K, P]) TryPush(id K, priority P) error {
+	return TryPush(h, id, priority)
+}
+
+func (h *Heap[K, P]) Peek() (K, P) {
+	return Peek(h)
+}
+
+func (h *Heap[K, P]) TryPeek() (K, P, error) {
+	return TryPeek(h)
+}
+
+func (h *Heap[K, P]) PopTop() (K, P) {
+	return PopTop(h)
+}
+
+func (h *Heap[K, P]) TryPopTop() (K, P, error) {
+	return TryPopTop(h)
+}
+
+func (h *Heap[K, P]) DecreaseKey(id K, priority P) {
+	DecreaseKey(h, id, priority)
+}
+
+func (h *Heap[K, P]) TryDecreaseKey(id K, priority P) error {
+	return TryDecreaseKey(h, id, priority)
+}
+
+func (h *Heap[K, P]) IncreaseKey(id K, priority P) {
+	IncreaseKey(h, id, priority)
+}
+
+func (h *Heap[K, P]) TryIncreaseKey(id K, priority P) error {
+	return TryIncreaseKey(h, id, priority)
+}
+
+func (h *Heap[K, P]) Delete(id K) (P, bool) {
+	return Delete(h, id)
+}
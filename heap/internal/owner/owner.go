@@ -0,0 +1,38 @@
+// Package owner tells which heap a handle belongs to. Every handle points at the
+// cell of the heap it was pushed into, and Meld chains the cell of the emptied heap
+// to the one of the heap which took its items, so it doesn't have to visit them.
+// That is the union-find without ranks, so Heap is O(log n) amortised at worst and
+// about O(1) in practice
+package owner
+
+// Cell stands for a heap, or for the heap it was melded into if next isn't nil
+type Cell[H any] struct {
+	heap *H
+	next *Cell[H]
+}
+
+func New[H any](heap *H) *Cell[H] {
+	return &Cell[H]{heap: heap}
+}
+
+// Heap returns the heap which owns the handles pointing at c. The cells on the
+// way are pointed straight at the last one so that the next lookups are short
+func (c *Cell[H]) Heap() *H {
+	root := c
+	for root.next != nil {
+		root = root.next
+	}
+
+	for c != root {
+		c, c.next = c.next, root
+	}
+
+	return root.heap
+}
+
+// Meld hands the handles pointing at from over to the heap of into.
+// from must be the current cell of a heap, which then needs a new one
+func Meld[H any](from, into *Cell[H]) {
+	from.heap = nil
+	from.next = into
+}
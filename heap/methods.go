@@ -0,0 +1,43 @@
+package heap
+
+// Method forms of the package-level functions
+
+func (h *Heap[T]) Len() int {
+	return Len(h)
+}
+
+func (h *Heap[T]) Empty() bool {
+	return Empty(h)
+}
+
+func (h *Heap[T]) Push(item T) {
+	Push(h, item)
+}
+
+func (h *Heap[T]) Peek() T {
+	return Peek(h)
+}
+
+func (h *Heap[T]) TryPeek() (T, error) {
+	return TryPeek(h)
+}
+
+func (h *Heap[T]) PopTop() T {
+	return PopTop(h)
+}
+
+func (h *Heap[T]) TryPopTop() (T, error) {
+	return TryPopTop(h)
+}
+
+func (h *Heap[T]) Fix(index int) {
+	Fix(h, index)
+}
+
+func (h *Heap[T]) Remove(index int) T {
+	return Remove(h, index)
+}
+
+func (h *Heap[T]) TryRemove(index int) (T, error) {
+	return TryRemove(h, index)
+}
@@ -0,0 +1,241 @@
+// Package pairing is a pairing heap: a heap-ordered multiway tree where every
+// operation but PopTop just links two trees together. Push and Meld are O(1),
+// PopTop is O(log n) amortised and DecreaseKey is o(log n) amortised,
+// which is O(1) for all practical purposes
+package pairing
+
+import (
+	"cmp"
+
+	"github.com/kirillrogovoy/computer-science/bounds"
+	"github.com/kirillrogovoy/computer-science/heap"
+	"github.com/kirillrogovoy/computer-science/heap/internal/owner"
+)
+
+var (
+	ErrEmpty    = bounds.ErrEmpty
+	ErrWrongWay = heap.ErrWrongWay
+	ErrRemoved  = heap.ErrRemoved
+	ErrForeign  = heap.ErrForeign
+	ErrSelfMeld = heap.ErrSelfMeld
+)
+
+// Handle is a node of the heap returned by Insert. It stays valid until the item is popped
+type Handle[T any] struct {
+	item    T
+	child   *Handle[T]
+	sibling *Handle[T]
+	// prev is the parent for the leftmost child and the left sibling for the rest
+	prev  *Handle[T]
+	owner *owner.Cell[Heap[T]]
+}
+
+func (n *Handle[T]) Item() T {
+	return n.item
+}
+
+type Heap[T any] struct {
+	root    *Handle[T]
+	size    int
+	compare func(a, b T) int
+	// owner is shared by the handles of the heap so that Meld can hand them over in O(1)
+	owner *owner.Cell[Heap[T]]
+}
+
+// NewMin returns a heap which pops the smallest item first
+func NewMin[T cmp.Ordered]() *Heap[T] {
+	return NewFunc(heap.MinFirst[T])
+}
+
+// NewMax returns a heap which pops the greatest item first
+func NewMax[T cmp.Ordered]() *Heap[T] {
+	return NewFunc(heap.MaxFirst[T])
+}
+
+// NewFunc returns a heap which pops first the item compare considers the smallest
+func NewFunc[T any](compare func(a, b T) int) *Heap[T] {
+	h := &Heap[T]{nil, 0, compare, nil}
+	h.owner = owner.New(h)
+	return h
+}
+
+func Len[T any](h *Heap[T]) int {
+	return h.size
+}
+
+func Empty[T any](h *Heap[T]) bool {
+	return h.size == 0
+}
+
+func Push[T any](h *Heap[T], item T) {
+	Insert(h, item)
+}
+
+// Insert pushes item and returns a handle for DecreaseKey
+func Insert[T any](h *Heap[T], item T) *Handle[T] {
+	n := &Handle[T]{item: item, owner: h.owner}
+	h.root = meld(h, h.root, n)
+	h.size++
+
+	return n
+}
+
+func Peek[T any](h *Heap[T]) T {
+	item, err := TryPeek(h)
+	if err != nil {
+		panic(err)
+	}
+
+	return item
+}
+
+func TryPeek[T any](h *Heap[T]) (T, error) {
+	if Empty(h) {
+		var zero T
+		return zero, bounds.ErrEmpty
+	}
+
+	return h.root.item, nil
+}
+
+func PopTop[T any](h *Heap[T]) T {
+	item, err := TryPopTop(h)
+	if err != nil {
+		panic(err)
+	}
+
+	return item
+}
+
+func TryPopTop[T any](h *Heap[T]) (T, error) {
+	if Empty(h) {
+		var zero T
+		return zero, bounds.ErrEmpty
+	}
+
+	top := h.root
+	h.root = mergePairs(h, top.child)
+	h.size--
+
+	// a popped handle has neither a parent nor a place as the root
+	top.child = nil
+
+	return top.item, nil
+}
+
+// DecreaseKey moves n towards the top by giving it an item which compare
+// doesn't put after the current one
+func DecreaseKey[T any](h *Heap[T], n *Handle[T], item T) {
+	if err := TryDecreaseKey(h, n, item); err != nil {
+		panic(err)
+	}
+}
+
+func TryDecreaseKey[T any](h *Heap[T], n *Handle[T], item T) error {
+	if n.owner.Heap() != h {
+		return ErrForeign
+	}
+
+	if n != h.root && n.prev == nil {
+		return ErrRemoved
+	}
+
+	if h.compare(item, n.item) > 0 {
+		return ErrWrongWay
+	}
+
+	n.item = item
+	if n == h.root {
+		return nil
+	}
+
+	// cut n with its subtree out and link it back to the root
+	if n.prev.child == n {
+		n.prev.child = n.sibling
+	} else {
+		n.prev.sibling = n.sibling
+	}
+
+	if n.sibling != nil {
+		n.sibling.prev = n.prev
+	}
+
+	n.sibling, n.prev = nil, nil
+	h.root = meld(h, h.root, n)
+
+	return nil
+}
+
+// Meld moves all items of other into h in O(1), leaving other empty.
+// Handles of other's items keep working with h
+func Meld[T any](h *Heap[T], other *Heap[T]) {
+	if h == other {
+		panic(ErrSelfMeld)
+	}
+
+	h.root = meld(h, h.root, other.root)
+	h.size += other.size
+	owner.Meld(other.owner, h.owner)
+
+	other.root = nil
+	other.size = 0
+	other.owner = owner.New(other)
+}
+
+// meld links two trees making the root which goes later the leftmost child of the other one
+func meld[T any](h *Heap[T], a, b *Handle[T]) *Handle[T] {
+	if a == nil {
+		return b
+	}
+
+	if b == nil {
+		return a
+	}
+
+	if h.compare(b.item, a.item) < 0 {
+		a, b = b, a
+	}
+
+	b.sibling = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+
+	a.child = b
+	b.prev = a
+
+	return a
+}
+
+// mergePairs turns a list of siblings into one tree with the classic two passes:
+// meld pairs left to right, then meld the results right to left
+func mergePairs[T any](h *Heap[T], first *Handle[T]) *Handle[T] {
+	// the results of the first pass are chained through sibling in reverse order
+	var pairs *Handle[T]
+	for first != nil {
+		a := first
+		b := a.sibling
+		if b == nil {
+			first = nil
+		} else {
+			first = b.sibling
+			b.sibling, b.prev = nil, nil
+		}
+
+		a.sibling, a.prev = nil, nil
+
+		pair := meld(h, a, b)
+		pair.sibling = pairs
+		pairs = pair
+	}
+
+	var root *Handle[T]
+	for pairs != nil {
+		next := pairs.sibling
+		pairs.sibling = nil
+		root = meld(h, root, pairs)
+		pairs = next
+	}
+
+	return root
+}
@@ -0,0 +1,189 @@
+package pairing
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// requireHeap checks that every tree is heap-ordered, that the back links are right
+// and that the number of nodes matches Len
+func requireHeap[T any](t *testing.T, h *Heap[T]) {
+	if h.root == nil {
+		require.Equal(t, 0, Len(h))
+		return
+	}
+
+	require.Nil(t, h.root.prev)
+	require.Nil(t, h.root.sibling)
+	require.Equal(t, Len(h), requireTree(t, h, h.root))
+}
+
+// requireTree returns the number of nodes in the tree
+func requireTree[T any](t *testing.T, h *Heap[T], n *Handle[T]) int {
+	count := 1
+	prev := n
+	for child := n.child; child != nil; child = child.sibling {
+		require.Equal(t, prev, child.prev)
+		require.Equal(t, false, h.compare(child.item, n.item) < 0)
+		count += requireTree(t, h, child)
+		prev = child
+	}
+
+	return count
+}
+
+func popAll[T any](h *Heap[T]) []T {
+	result := []T{}
+	for !Empty(h) {
+		result = append(result, PopTop(h))
+	}
+	return result
+}
+
+func TestPushPop(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+	h := NewMin[int]()
+
+	items := []int{}
+	for i := 0; i < 300; i++ {
+		item := random.Intn(100)
+		items = append(items, item)
+		Push(h, item)
+		requireHeap(t, h)
+	}
+
+	require.Equal(t, slices.Min(items), Peek(h))
+
+	slices.Sort(items)
+	for _, expected := range items {
+		require.Equal(t, expected, PopTop(h))
+		requireHeap(t, h)
+	}
+}
+
+func TestMaxHeap(t *testing.T) {
+	h := NewMax[string]()
+	for _, item := range []string{"b", "d", "a", "c"} {
+		h.Push(item)
+	}
+
+	require.Equal(t, "d", h.Peek())
+	require.Equal(t, []string{"d", "c", "b", "a"}, popAll(h))
+}
+
+func TestDecreaseKey(t *testing.T) {
+	random := rand.New(rand.NewSource(3))
+	h := NewMin[int]()
+
+	handles := []*Handle[int]{}
+	for i := 0; i < 200; i++ {
+		handles = append(handles, Insert(h, 1000+random.Intn(1000)))
+	}
+
+	// pop a few so that the trees have children
+	for i := 0; i < 10; i++ {
+		PopTop(h)
+	}
+
+	items := []int{}
+	for _, handle := range handles {
+		if handle != h.root && handle.prev == nil {
+			continue
+		}
+
+		if random.Intn(2) == 0 {
+			DecreaseKey(h, handle, handle.Item()-random.Intn(1500))
+			requireHeap(t, h)
+		}
+
+		items = append(items, handle.Item())
+	}
+
+	slices.Sort(items)
+	require.Equal(t, items, popAll(h))
+}
+
+func TestMeld(t *testing.T) {
+	a := NewMin[int]()
+	b := NewMin[int]()
+	for i := 0; i < 13; i++ {
+		a.Push(i * 2)
+	}
+
+	var handle *Handle[int]
+	for i := 0; i < 6; i++ {
+		handle = b.Insert(i*2 + 1)
+	}
+
+	a.Meld(b)
+	requireHeap(t, a)
+	require.Equal(t, 19, a.Len())
+	require.Equal(t, true, b.Empty())
+
+	// handles from the other heap now belong to the merged one
+	a.DecreaseKey(handle, -1)
+	require.Equal(t, -1, a.Peek())
+
+	expected := []int{-1, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 12, 14, 16, 18, 20, 22, 24}
+	require.Equal(t, expected, popAll(a))
+
+	require.PanicsWithValue(t, ErrSelfMeld, func() { a.Meld(a) })
+}
+
+func TestErrors(t *testing.T) {
+	h := NewMin[int]()
+
+	_, err := TryPeek(h)
+	require.Equal(t, ErrEmpty, err)
+	_, err = TryPopTop(h)
+	require.Equal(t, ErrEmpty, err)
+
+	handle := h.Insert(5)
+	require.Equal(t, ErrWrongWay, h.TryDecreaseKey(handle, 6))
+	require.NoError(t, h.TryDecreaseKey(handle, 5))
+
+	h.PopTop()
+	require.Equal(t, ErrRemoved, h.TryDecreaseKey(handle, 0))
+	require.Panics(t, func() { h.PopTop() })
+}
+
+func TestForeignHandle(t *testing.T) {
+	a := NewMin[int]()
+	b := NewMin[int]()
+	for i := 0; i < 8; i++ {
+		a.Push(i)
+		b.Push(i + 10)
+	}
+
+	handle := a.Insert(5)
+	leaf := b.Insert(20)
+
+	// neither heap changes when it gets a handle of the other one
+	require.Equal(t, ErrForeign, b.TryDecreaseKey(handle, 0))
+	require.Equal(t, ErrForeign, a.TryDecreaseKey(leaf, 0))
+	require.Panics(t, func() { b.DecreaseKey(handle, 0) })
+	requireHeap(t, a)
+	requireHeap(t, b)
+	require.Equal(t, 9, a.Len())
+	require.Equal(t, 10, b.Peek())
+
+	// the handles of a melded heap move to the heap which took the items, also through a chain of melds
+	c := NewMin[int]()
+	c.Meld(b)
+	a.Meld(c)
+	require.Equal(t, ErrForeign, b.TryDecreaseKey(leaf, 0))
+	require.Equal(t, ErrForeign, c.TryDecreaseKey(leaf, 0))
+	require.NoError(t, a.TryDecreaseKey(leaf, -1))
+	require.Equal(t, -1, a.Peek())
+
+	// the emptied heaps get handles of their own again
+	reused := b.Insert(3)
+	require.Equal(t, ErrForeign, a.TryDecreaseKey(reused, 0))
+	require.NoError(t, b.TryDecreaseKey(reused, 0))
+	require.Equal(t, 0, b.Peek())
+	requireHeap(t, a)
+	requireHeap(t, b)
+}
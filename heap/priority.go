@@ -0,0 +1,40 @@
+package heap
+
+import (
+	"cmp"
+	"errors"
+)
+
+// The errors of DecreaseKey and Meld in the heaps with handles or ids. The packages of those heaps alias them
+var (
+	// ErrWrongWay is returned when DecreaseKey would move an item away from the top or IncreaseKey towards it
+	ErrWrongWay = errors.New("new priority moves the entry the wrong way")
+	ErrRemoved  = errors.New("handle was already popped from the heap")
+	ErrForeign  = errors.New("handle belongs to another heap")
+	// ErrSelfMeld is what Meld panics with when asked to meld a heap into itself
+	ErrSelfMeld = errors.New("tried to meld a heap with itself")
+)
+
+// PriorityQueue is what the binary heap has in common with the meldable heaps
+// in the binomial, pairing and fibonacci packages, so they can be swapped for one another
+type PriorityQueue[T any] interface {
+	Len() int
+	Empty() bool
+	Push(item T)
+	Peek() T
+	TryPeek() (T, error)
+	PopTop() T
+	TryPopTop() (T, error)
+}
+
+var _ PriorityQueue[int] = (*Heap[int])(nil)
+
+// MinFirst is the order of NewMin heaps: the smallest item is the top
+func MinFirst[T cmp.Ordered](a, b T) int {
+	return cmp.Compare(a, b)
+}
+
+// MaxFirst is the order of NewMax heaps: the greatest item is the top
+func MaxFirst[T cmp.Ordered](a, b T) int {
+	return cmp.Compare(b, a)
+}
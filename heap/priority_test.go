@@ -0,0 +1,242 @@
+package heap_test
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/kirillrogovoy/computer-science/heap"
+	"github.com/kirillrogovoy/computer-science/heap/binomial"
+	"github.com/kirillrogovoy/computer-science/heap/fibonacci"
+	"github.com/kirillrogovoy/computer-science/heap/indexed"
+	"github.com/kirillrogovoy/computer-science/heap/pairing"
+	"github.com/stretchr/testify/require"
+)
+
+var queues = []struct {
+	name string
+	new  func() heap.PriorityQueue[int]
+}{
+	{"binary", func() heap.PriorityQueue[int] { return heap.NewMin[int]() }},
+	{"binomial", func() heap.PriorityQueue[int] { return binomial.NewMin[int]() }},
+	{"pairing", func() heap.PriorityQueue[int] { return pairing.NewMin[int]() }},
+	{"fibonacci", func() heap.PriorityQueue[int] { return fibonacci.NewMin[int]() }},
+}
+
+// TestPriorityQueues runs the same operations against every implementation
+func TestPriorityQueues(t *testing.T) {
+	for _, queue := range queues {
+		t.Run(queue.name, func(t *testing.T) {
+			random := rand.New(rand.NewSource(42))
+			q := queue.new()
+			model := []int{}
+
+			_, err := q.TryPopTop()
+			require.Equal(t, heap.ErrEmpty, err)
+
+			for i := 0; i < 3000; i++ {
+				if random.Intn(3) > 0 || len(model) == 0 {
+					item := random.Intn(500)
+					q.Push(item)
+					model = append(model, item)
+				} else {
+					slices.Sort(model)
+					require.Equal(t, model[0], q.Peek())
+					require.Equal(t, model[0], q.PopTop())
+					model = model[1:]
+				}
+
+				require.Equal(t, len(model), q.Len())
+			}
+
+			slices.Sort(model)
+			for _, expected := range model {
+				require.Equal(t, expected, q.PopTop())
+			}
+			require.Equal(t, true, q.Empty())
+		})
+	}
+}
+
+var benchmarkSizes = []int{1000, 100000}
+
+func TestSharedErrors(t *testing.T) {
+	for _, err := range []error{binomial.ErrWrongWay, pairing.ErrWrongWay, fibonacci.ErrWrongWay, indexed.ErrWrongWay} {
+		require.Equal(t, heap.ErrWrongWay, err)
+	}
+
+	for _, err := range []error{binomial.ErrRemoved, pairing.ErrRemoved, fibonacci.ErrRemoved} {
+		require.Equal(t, heap.ErrRemoved, err)
+	}
+}
+
+func BenchmarkPushPop(b *testing.B) {
+	for _, queue := range queues {
+		for _, size := range benchmarkSizes {
+			b.Run(fmt.Sprintf("%s/%d", queue.name, size), func(b *testing.B) {
+				items := rand.New(rand.NewSource(1)).Perm(size)
+
+				for i := 0; i < b.N; i++ {
+					q := queue.new()
+					for _, item := range items {
+						q.Push(item)
+					}
+					for !q.Empty() {
+						q.PopTop()
+					}
+				}
+			})
+		}
+	}
+}
+
+// decreaseKeyQueue is the part of the meldable heaps the DecreaseKey benchmark needs
+type decreaseKeyQueue[H any] struct {
+	insert      func(item int) H
+	decreaseKey func(handle H, item int)
+	popTop      func() int
+}
+
+func benchmarkDecreaseKey[H any](b *testing.B, size int, new func() decreaseKeyQueue[H]) {
+	random := rand.New(rand.NewSource(1))
+	items := random.Perm(size)
+	decreases := random.Perm(size)
+
+	for i := 0; i < b.N; i++ {
+		q := new()
+		handles := make([]H, size)
+		for j, item := range items {
+			handles[j] = q.insert(size + item)
+		}
+
+		// a Dijkstra-like workload: every item gets decreased once, interleaved with pops
+		for j, index := range decreases {
+			q.decreaseKey(handles[index], j)
+			if j%4 == 0 {
+				q.popTop()
+			}
+		}
+	}
+}
+
+// BenchmarkDecreaseKey compares the meldable heaps with the indexed binary heap
+func BenchmarkDecreaseKey(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		b.Run(fmt.Sprintf("indexed/%d", size), func(b *testing.B) {
+			random := rand.New(rand.NewSource(1))
+			items := random.Perm(size)
+			decreases := random.Perm(size)
+
+			for i := 0; i < b.N; i++ {
+				h := indexed.NewMin[int, int]()
+				for id, item := range items {
+					h.Push(id, size+item)
+				}
+
+				for j, id := range decreases {
+					// the id may have been popped already
+					if h.Contains(id) {
+						h.DecreaseKey(id, j)
+					}
+					if j%4 == 0 {
+						h.PopTop()
+					}
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("binomial/%d", size), func(b *testing.B) {
+			benchmarkDecreaseKey(b, size, func() decreaseKeyQueue[*binomial.Handle[int]] {
+				h := binomial.NewMin[int]()
+				return decreaseKeyQueue[*binomial.Handle[int]]{h.Insert, decreaseIgnoringPopped(h.TryDecreaseKey), h.PopTop}
+			})
+		})
+
+		b.Run(fmt.Sprintf("pairing/%d", size), func(b *testing.B) {
+			benchmarkDecreaseKey(b, size, func() decreaseKeyQueue[*pairing.Handle[int]] {
+				h := pairing.NewMin[int]()
+				return decreaseKeyQueue[*pairing.Handle[int]]{h.Insert, decreaseIgnoringPopped(h.TryDecreaseKey), h.PopTop}
+			})
+		})
+
+		b.Run(fmt.Sprintf("fibonacci/%d", size), func(b *testing.B) {
+			benchmarkDecreaseKey(b, size, func() decreaseKeyQueue[*fibonacci.Handle[int]] {
+				h := fibonacci.NewMin[int]()
+				return decreaseKeyQueue[*fibonacci.Handle[int]]{h.Insert, decreaseIgnoringPopped(h.TryDecreaseKey), h.PopTop}
+			})
+		})
+	}
+}
+
+// decreaseIgnoringPopped skips the handles which have been popped already, like the indexed benchmark does
+func decreaseIgnoringPopped[H any](tryDecreaseKey func(handle H, item int) error) func(handle H, item int) {
+	return func(handle H, item int) {
+		tryDecreaseKey(handle, item)
+	}
+}
+
+// BenchmarkMeld compares melding two heaps with pushing the items of one into the other
+func BenchmarkMeld(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		items := rand.New(rand.NewSource(1)).Perm(size)
+
+		b.Run(fmt.Sprintf("binary/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				a, other := heap.NewMin[int](), heap.NewMin[int]()
+				for _, item := range items {
+					a.Push(item)
+					other.Push(item)
+				}
+				b.StartTimer()
+
+				for !other.Empty() {
+					a.Push(other.PopTop())
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("binomial/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				a, other := binomial.NewMin[int](), binomial.NewMin[int]()
+				for _, item := range items {
+					a.Push(item)
+					other.Push(item)
+				}
+				b.StartTimer()
+
+				a.Meld(other)
+			}
+		})
+
+		b.Run(fmt.Sprintf("pairing/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				a, other := pairing.NewMin[int](), pairing.NewMin[int]()
+				for _, item := range items {
+					a.Push(item)
+					other.Push(item)
+				}
+				b.StartTimer()
+
+				a.Meld(other)
+			}
+		})
+
+		b.Run(fmt.Sprintf("fibonacci/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				a, other := fibonacci.NewMin[int](), fibonacci.NewMin[int]()
+				for _, item := range items {
+					a.Push(item)
+					other.Push(item)
+				}
+				b.StartTimer()
+
+				a.Meld(other)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Package bintree has the walks which every binary search tree of this repository
+// does the same way, whatever it keeps in its nodes to stay balanced
+package bintree
+
+import (
+	"iter"
+
+	"github.com/kirillrogovoy/computer-science/deque"
+)
+
+// Node is what the walks need from a node of a tree. N is the node type itself,
+// a pointer which is nil where a subtree is empty
+type Node[K, V, N any] interface {
+	comparable
+	Key() K
+	Value() V
+	Left() N
+	Right() N
+}
+
+func isNil[N comparable](n N) bool {
+	var none N
+	return n == none
+}
+
+// Min returns the smallest key under root and its value
+func Min[K, V any, N Node[K, V, N]](root N) (K, V, bool) {
+	if isNil(root) {
+		return none[K, V]()
+	}
+
+	n := root
+	for !isNil(n.Left()) {
+		n = n.Left()
+	}
+
+	return n.Key(), n.Value(), true
+}
+
+// Max returns the greatest key under root and its value
+func Max[K, V any, N Node[K, V, N]](root N) (K, V, bool) {
+	if isNil(root) {
+		return none[K, V]()
+	}
+
+	n := root
+	for !isNil(n.Right()) {
+		n = n.Right()
+	}
+
+	return n.Key(), n.Value(), true
+}
+
+// Floor returns the greatest key under root which is less than or equal to key
+func Floor[K, V any, N Node[K, V, N]](root N, key K, compare func(a, b K) int) (K, V, bool) {
+	var floor N
+	for n := root; !isNil(n); {
+		c := compare(key, n.Key())
+		switch {
+		case c < 0:
+			n = n.Left()
+		case c > 0:
+			// n fits, but there may be a greater one on the right
+			floor = n
+			n = n.Right()
+		default:
+			return n.Key(), n.Value(), true
+		}
+	}
+
+	if isNil(floor) {
+		return none[K, V]()
+	}
+
+	return floor.Key(), floor.Value(), true
+}
+
+// Ceiling returns the smallest key under root which is greater than or equal to key
+func Ceiling[K, V any, N Node[K, V, N]](root N, key K, compare func(a, b K) int) (K, V, bool) {
+	var ceiling N
+	for n := root; !isNil(n); {
+		c := compare(key, n.Key())
+		switch {
+		case c > 0:
+			n = n.Right()
+		case c < 0:
+			// n fits, but there may be a smaller one on the left
+			ceiling = n
+			n = n.Left()
+		default:
+			return n.Key(), n.Value(), true
+		}
+	}
+
+	if isNil(ceiling) {
+		return none[K, V]()
+	}
+
+	return ceiling.Key(), ceiling.Value(), true
+}
+
+// InOrder yields the keys under root in order: the left subtree, the node, then the right subtree.
+// The path from the root is kept on an explicit stack, so deep trees don't grow the call stack.
+// The trees wrap it into their All, which also checks for concurrent modifications after every yield
+func InOrder[K, V any, N Node[K, V, N]](root N, yield func(K, V) bool) {
+	stack := deque.Create[N](0)
+
+	for n := root; !isNil(n) || !stack.IsEmpty(); {
+		// go as far left as possible, remembering the way back
+		for ; !isNil(n); n = n.Left() {
+			stack.PushBack(n)
+		}
+
+		n = stack.PopBack()
+		if !yield(n.Key(), n.Value()) {
+			return
+		}
+
+		n = n.Right()
+	}
+}
+
+// Keys yields the keys of all
+func Keys[K, V any](all iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range all {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values yields the values of all
+func Values[K, V any](all iter.Seq2[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range all {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+func none[K, V any]() (K, V, bool) {
+	var key K
+	var value V
+	return key, value, false
+}
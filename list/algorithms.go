@@ -0,0 +1,167 @@
+package list
+
+import (
+	"github.com/kirillrogovoy/computer-science/bounds"
+)
+
+// HasCycle tells if following next pointers from the front ever comes back to a visited node,
+// using Floyd's tortoise and hare. The functions of this package never create cycles,
+// but a bug in the relinking code could, e.g. forgetting to reset next of the old first node in Reverse
+func HasCycle(l *List) bool {
+	_, ok := meetingPoint(l)
+	return ok
+}
+
+// CycleStart returns the index of the node where the cycle begins
+func CycleStart(l *List) (int, bool) {
+	meeting, ok := meetingPoint(l)
+	if !ok {
+		return 0, false
+	}
+
+	// the distance from the front to the start of the cycle equals
+	// the distance from the meeting point to it, modulo the length of the cycle
+	index := 0
+	for slow := l.first; slow != meeting; slow = slow.next {
+		meeting = meeting.next
+		index++
+	}
+
+	return index, true
+}
+
+func meetingPoint(l *List) (*node, bool) {
+	slow, fast := l.first, l.first
+	for fast != nil && fast.next != nil {
+		slow = slow.next
+		fast = fast.next.next
+
+		if slow == fast {
+			return slow, true
+		}
+	}
+
+	return nil, false
+}
+
+// Middle returns the value in the middle in a single pass. Of two middle values it returns the second one
+func Middle(l *List) (int, bool) {
+	if Empty(l) {
+		return 0, false
+	}
+
+	// fast moves twice as fast, so slow is in the middle when fast reaches the end
+	slow, fast := l.first, l.first
+	for fast != nil && fast.next != nil {
+		slow = slow.next
+		fast = fast.next.next
+	}
+
+	return slow.value, true
+}
+
+// NthFromBack returns the n-th value counting from the back, 1 being the last one.
+// It doesn't rely on the size, but keeps two pointers n nodes apart
+func NthFromBack(l *List, n int) (int, bool) {
+	if n <= 0 {
+		return 0, false
+	}
+
+	lead := l.first
+	for i := 0; i < n; i++ {
+		if lead == nil {
+			return 0, false
+		}
+		lead = lead.next
+	}
+
+	cur := l.first
+	for lead != nil {
+		lead = lead.next
+		cur = cur.next
+	}
+
+	return cur.value, true
+}
+
+// SplitAt moves the nodes before index to the first list and the rest to the second one.
+// l is left empty
+func SplitAt(l *List, index int) (*List, *List, error) {
+	size := Size(l)
+
+	// allow negative index, means "from the end"
+	index, err := bounds.NormalizeInsert(index, size)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	front, back := New(), New()
+	if index == 0 {
+		back.first, back.last, back.size = l.first, l.last, size
+	} else {
+		prev, _ := nodeAt(l, index-1)
+
+		front.first, front.last, front.size = l.first, prev, index
+		if prev.next != nil {
+			back.first, back.last, back.size = prev.next, l.last, size-index
+		}
+		prev.next = nil
+	}
+
+	l.first = nil
+	l.last = nil
+	l.size = 0
+	l.mods++
+
+	return front, back, nil
+}
+
+// Concat moves the nodes of b to the back of a in O(1). b is left empty
+func Concat(a *List, b *List) {
+	if a == b {
+		panic(ErrSameList)
+	}
+
+	if Empty(b) {
+		return
+	}
+
+	if Empty(a) {
+		a.first = b.first
+	} else {
+		a.last.next = b.first
+	}
+	a.last = b.last
+	a.size += b.size
+	a.mods++
+
+	b.first = nil
+	b.last = nil
+	b.size = 0
+	b.mods++
+}
+
+// Rotate moves the last k nodes to the front. Negative k rotates the other way
+func Rotate(l *List, k int) {
+	size := Size(l)
+	if size < 2 {
+		return
+	}
+
+	k %= size
+	if k < 0 {
+		k += size
+	}
+	if k == 0 {
+		return
+	}
+
+	newLast, _ := nodeAt(l, size-k-1)
+
+	// close the ring and cut it at the new last node
+	l.last.next = l.first
+	l.first = newLast.next
+	newLast.next = nil
+	l.last = newLast
+	l.mods++
+}
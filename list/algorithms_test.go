@@ -0,0 +1,164 @@
+package list
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestHasCycle(t *testing.T) {
+	require.Equal(t, false, HasCycle(New()))
+	require.Equal(t, false, HasCycle(newList(1)))
+	require.Equal(t, false, HasCycle(newList(1, 2, 3, 4, 5)))
+
+	l := newList(1, 2, 3, 4, 5)
+	Reverse(l)
+	require.Equal(t, false, l.HasCycle(), "Reverse() must not leave a cycle behind")
+
+	// HACK: make the last node point back to the third one
+	l = newList(1, 2, 3, 4, 5)
+	third, _ := nodeAt(l, 2)
+	l.last.next = third
+	require.Equal(t, true, HasCycle(l))
+
+	// a node pointing to itself
+	l = newList(1)
+	l.first.next = l.first
+	require.Equal(t, true, HasCycle(l))
+}
+
+func TestCycleStart(t *testing.T) {
+	_, ok := CycleStart(newList(1, 2, 3))
+	require.Equal(t, false, ok)
+
+	for size := 1; size < 8; size++ {
+		for start := 0; start < size; start++ {
+			values := make([]int, size)
+			l := newList(values...)
+
+			// HACK: close the list into a ring starting at start
+			startNode, _ := nodeAt(l, start)
+			l.last.next = startNode
+
+			index, ok := l.CycleStart()
+			require.Equal(t, true, ok)
+			require.Equal(t, start, index, "size %d", size)
+		}
+	}
+}
+
+func TestMiddle(t *testing.T) {
+	_, ok := Middle(New())
+	require.Equal(t, false, ok)
+
+	testMap := map[int][]int{
+		1: {1},
+		2: {1, 2},
+		3: {1, 3, 5},
+		4: {1, 2, 4, 5},
+	}
+
+	for expected, values := range testMap {
+		res, ok := newList(values...).Middle()
+		require.Equal(t, true, ok)
+		require.Equal(t, expected, res)
+	}
+}
+
+func TestNthFromBack(t *testing.T) {
+	l := newList(1, 2, 3, 4)
+
+	for n := 1; n <= 4; n++ {
+		res, ok := NthFromBack(l, n)
+		require.Equal(t, true, ok)
+		require.Equal(t, 5-n, res)
+
+		// the same as a negative index
+		atRes, _ := At(l, -n)
+		require.Equal(t, atRes, res)
+	}
+
+	_, ok := NthFromBack(l, 5)
+	require.Equal(t, false, ok)
+	_, ok = l.NthFromBack(0)
+	require.Equal(t, false, ok)
+	_, ok = NthFromBack(New(), 1)
+	require.Equal(t, false, ok)
+}
+
+func TestSplitAt(t *testing.T) {
+	for index := 0; index <= 4; index++ {
+		l := newList(1, 2, 3, 4)
+		front, back, err := SplitAt(l, index)
+
+		require.Nil(t, err)
+		requireList(t, front, []int{1, 2, 3, 4}[:index])
+		requireList(t, back, []int{1, 2, 3, 4}[index:])
+		requireList(t, l, []int{})
+	}
+
+	front, back, err := newList(1, 2, 3).SplitAt(-1)
+	require.Nil(t, err)
+	requireList(t, front, []int{1, 2})
+	requireList(t, back, []int{3})
+
+	_, _, err = SplitAt(newList(1, 2, 3), 4)
+	require.Equal(t, &IndexError{Index: 4, Size: 3}, err)
+
+	front, back, err = SplitAt(New(), 0)
+	require.Nil(t, err)
+	requireList(t, front, []int{})
+	requireList(t, back, []int{})
+}
+
+func TestConcat(t *testing.T) {
+	a := newList(1, 2)
+	b := newList(3, 4)
+
+	Concat(a, b)
+	requireList(t, a, []int{1, 2, 3, 4})
+	requireList(t, b, []int{})
+
+	// both lists stay usable
+	PushBack(a, 5)
+	PushBack(b, 6)
+	requireList(t, a, []int{1, 2, 3, 4, 5})
+	requireList(t, b, []int{6})
+
+	empty := New()
+	empty.Concat(b)
+	requireList(t, empty, []int{6})
+
+	Concat(a, New())
+	requireList(t, a, []int{1, 2, 3, 4, 5})
+}
+
+func TestConcatPanic(t *testing.T) {
+	l := newList(1)
+	require.PanicsWithValue(t, ErrSameList, func() { Concat(l, l) })
+}
+
+func TestRotate(t *testing.T) {
+	testMap := map[int][]int{
+		0:  {1, 2, 3, 4, 5},
+		1:  {5, 1, 2, 3, 4},
+		2:  {4, 5, 1, 2, 3},
+		5:  {1, 2, 3, 4, 5},
+		7:  {4, 5, 1, 2, 3},
+		-1: {2, 3, 4, 5, 1},
+		-6: {2, 3, 4, 5, 1},
+	}
+
+	for k, expected := range testMap {
+		l := newList(1, 2, 3, 4, 5)
+		Rotate(l, k)
+		requireList(t, l, expected)
+	}
+
+	l := New()
+	l.Rotate(3)
+	requireList(t, l, []int{})
+
+	l = newList(1)
+	l.Rotate(3)
+	requireList(t, l, []int{1})
+}
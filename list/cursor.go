@@ -0,0 +1,122 @@
+package list
+
+import (
+	"github.com/kirillrogovoy/computer-science/bounds"
+)
+
+// Cursor points at a node of a List. Reading, replacing, inserting after it,
+// removing the next node and advancing are all O(1), so a single walk
+// can do what would take a series of index-based calls otherwise.
+// Like iterators, a cursor panics with ErrConcurrentModification when the list
+// was structurally modified by anything but the cursor itself
+type Cursor struct {
+	list  *List
+	node  *node
+	index int
+	mods  int
+}
+
+func newCursor(l *List, n *node, index int) *Cursor {
+	return &Cursor{l, n, index, l.mods}
+}
+
+// FrontCursor returns a cursor at the first node or nil if the list is empty
+func FrontCursor(l *List) *Cursor {
+	if Empty(l) {
+		return nil
+	}
+
+	return newCursor(l, l.first, 0)
+}
+
+// BackCursor returns a cursor at the last node or nil if the list is empty
+func BackCursor(l *List) *Cursor {
+	if Empty(l) {
+		return nil
+	}
+
+	return newCursor(l, l.last, Size(l)-1)
+}
+
+func CursorAt(l *List, index int) (*Cursor, error) {
+	// allow negative index, means "from the end"
+	index, err := bounds.Normalize(index, Size(l))
+	if err != nil {
+		return nil, err
+	}
+
+	n, _ := nodeAt(l, index)
+	return newCursor(l, n, index), nil
+}
+
+// FindCursor returns a cursor at the first node holding value or nil
+func FindCursor(l *List, value int) *Cursor {
+	i := 0
+	for cur := l.first; cur != nil; cur = cur.next {
+		if cur.value == value {
+			return newCursor(l, cur, i)
+		}
+		i++
+	}
+
+	return nil
+}
+
+func (c *Cursor) check() {
+	if c.list.mods != c.mods {
+		panic(ErrConcurrentModification)
+	}
+}
+
+func (c *Cursor) Value() int {
+	c.check()
+	return c.node.value
+}
+
+// Index returns the position of the node in the list
+func (c *Cursor) Index() int {
+	c.check()
+	return c.index
+}
+
+// Set replaces the value of the node
+func (c *Cursor) Set(value int) {
+	c.check()
+	c.node.value = value
+}
+
+// HasNext tells if Next can advance the cursor
+func (c *Cursor) HasNext() bool {
+	c.check()
+	return c.node.next != nil
+}
+
+// Next moves the cursor to the next node. At the last node it returns false and stays
+func (c *Cursor) Next() bool {
+	if !c.HasNext() {
+		return false
+	}
+
+	c.node = c.node.next
+	c.index++
+	return true
+}
+
+// InsertAfter adds a node right after the cursor. The cursor stays where it is
+func (c *Cursor) InsertAfter(value int) {
+	c.check()
+	insertAfter(c.list, c.node, value)
+	c.mods = c.list.mods
+}
+
+// RemoveNext removes the node right after the cursor and returns its value.
+// It returns false when the cursor is at the last node
+func (c *Cursor) RemoveNext() (int, bool) {
+	if !c.HasNext() {
+		return 0, false
+	}
+
+	removed := removeAfter(c.list, c.node)
+	c.mods = c.list.mods
+	return removed.value, true
+}
@@ -0,0 +1,176 @@
+package list
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"slices"
+	"testing"
+)
+
+func newList(values ...int) *List {
+	l := New()
+	for _, value := range values {
+		PushBack(l, value)
+	}
+	return l
+}
+
+func TestFrontBackCursor(t *testing.T) {
+	l := New()
+	require.Nil(t, FrontCursor(l))
+	require.Nil(t, BackCursor(l))
+
+	PushBack(l, 1)
+	PushBack(l, 2)
+
+	c := FrontCursor(l)
+	require.Equal(t, 1, c.Value())
+	require.Equal(t, 0, c.Index())
+
+	c = l.BackCursor()
+	require.Equal(t, 2, c.Value())
+	require.Equal(t, 1, c.Index())
+	require.Equal(t, false, c.HasNext())
+}
+
+func TestCursorAt(t *testing.T) {
+	l := newList(1, 2, 3)
+
+	c, err := CursorAt(l, -2)
+	require.Nil(t, err)
+	require.Equal(t, 2, c.Value())
+	require.Equal(t, 1, c.Index())
+
+	_, err = l.CursorAt(3)
+	require.Equal(t, &IndexError{Index: 3, Size: 3}, err)
+}
+
+func TestFindCursor(t *testing.T) {
+	l := newList(1, 2, 3, 2)
+
+	require.Nil(t, FindCursor(l, 4))
+
+	c := l.FindCursor(2)
+	require.Equal(t, 2, c.Value())
+	require.Equal(t, 1, c.Index())
+}
+
+func TestCursorNext(t *testing.T) {
+	l := newList(1, 2, 3)
+
+	values := []int{}
+	c := FrontCursor(l)
+	for ok := c != nil; ok; ok = c.Next() {
+		values = append(values, c.Value())
+	}
+
+	require.Equal(t, []int{1, 2, 3}, values)
+	require.Equal(t, 2, c.Index())
+	require.Equal(t, false, c.Next())
+	require.Equal(t, 3, c.Value())
+}
+
+func TestCursorSet(t *testing.T) {
+	l := newList(1, 2, 3)
+
+	c := FrontCursor(l)
+	for ok := true; ok; ok = c.Next() {
+		c.Set(c.Value() * 10)
+	}
+
+	require.Equal(t, []int{10, 20, 30}, slices.Collect(Values(l)))
+}
+
+func TestCursorInsertAfter(t *testing.T) {
+	l := newList(1, 3)
+
+	c := FrontCursor(l)
+	c.InsertAfter(2)
+	require.Equal(t, 1, c.Value())
+	require.Equal(t, []int{1, 2, 3}, slices.Collect(Values(l)))
+	require.Equal(t, 3, Size(l))
+
+	// inserting after the last node moves l.last
+	c = BackCursor(l)
+	c.InsertAfter(4)
+	require.Equal(t, 4, l.last.value)
+	require.Equal(t, true, c.Next())
+	require.Equal(t, 4, c.Value())
+	require.Equal(t, 3, c.Index())
+	require.Equal(t, 4, Size(l))
+}
+
+func TestCursorRemoveNext(t *testing.T) {
+	l := newList(1, 2, 3)
+
+	c := FrontCursor(l)
+	value, ok := c.RemoveNext()
+	require.Equal(t, true, ok)
+	require.Equal(t, 2, value)
+	require.Equal(t, []int{1, 3}, slices.Collect(Values(l)))
+
+	// removing the last node moves l.last back to the cursor
+	value, ok = c.RemoveNext()
+	require.Equal(t, true, ok)
+	require.Equal(t, 3, value)
+	require.Equal(t, l.first, l.last)
+	require.Nil(t, l.last.next)
+	require.Equal(t, 1, Size(l))
+
+	_, ok = c.RemoveNext()
+	require.Equal(t, false, ok)
+}
+
+func TestCursorFilter(t *testing.T) {
+	l := newList(2, 1, 2, 2, 3, 4)
+
+	// drop every even value but the first one in a single pass
+	c := FrontCursor(l)
+	for c.HasNext() {
+		if c.node.next.value%2 == 0 {
+			c.RemoveNext()
+		} else {
+			c.Next()
+		}
+	}
+
+	require.Equal(t, []int{2, 1, 3}, slices.Collect(Values(l)))
+	require.Equal(t, 3, l.last.value)
+	require.Equal(t, 3, Size(l))
+}
+
+func TestCursorConcurrentModification(t *testing.T) {
+	l := newList(1, 2, 3)
+	c := FrontCursor(l)
+	other := FrontCursor(l)
+
+	// the cursor's own edits keep it valid
+	c.InsertAfter(5)
+	c.RemoveNext()
+	require.Equal(t, 1, c.Value())
+
+	defer func() {
+		err, _ := recover().(error)
+		require.Equal(t, true, errors.Is(err, ErrConcurrentModification))
+	}()
+
+	// but they invalidate the other cursors
+	other.Next()
+	t.Error("a stale cursor should panic")
+}
+
+func TestRemoveItemSinglePass(t *testing.T) {
+	l := newList(1, 2, 3)
+
+	require.Equal(t, true, RemoveItem(l, 3))
+	require.Equal(t, 2, l.last.value)
+	require.Nil(t, l.last.next)
+	require.Equal(t, 2, Size(l))
+
+	require.Equal(t, true, RemoveItem(l, 1))
+	require.Equal(t, l.first, l.last)
+	require.Equal(t, true, RemoveItem(l, 2))
+	require.Nil(t, l.first)
+	require.Nil(t, l.last)
+	require.Equal(t, false, RemoveItem(l, 2))
+}
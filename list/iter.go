@@ -0,0 +1,61 @@
+package list
+
+import (
+	"iter"
+)
+
+// All yields index-value pairs from the front to the back in a single pass.
+// Like the rest of the iterators, it panics with ErrConcurrentModification
+// if the loop body inserts or removes nodes
+func All(l *List) iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		mods := l.mods
+		i := 0
+		for cur := l.first; cur != nil; cur = cur.next {
+			if !yield(i, cur.value) {
+				return
+			}
+			checkMods(l, mods)
+			i++
+		}
+	}
+}
+
+// Values yields the values from the front to the back in a single pass
+func Values(l *List) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		mods := l.mods
+		for cur := l.first; cur != nil; cur = cur.next {
+			if !yield(cur.value) {
+				return
+			}
+			checkMods(l, mods)
+		}
+	}
+}
+
+// Backward yields index-value pairs from the back to the front.
+// The list is singly linked, so it buffers the values first which takes O(n) memory
+func Backward(l *List) iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		values := make([]int, 0, Size(l))
+		for cur := l.first; cur != nil; cur = cur.next {
+			values = append(values, cur.value)
+		}
+
+		mods := l.mods
+		for i := len(values) - 1; i >= 0; i-- {
+			if !yield(i, values[i]) {
+				return
+			}
+			checkMods(l, mods)
+		}
+	}
+}
+
+// checkMods fails fast when the list was structurally modified since an iterator saw mods
+func checkMods(l *List, mods int) {
+	if l.mods != mods {
+		panic(ErrConcurrentModification)
+	}
+}
@@ -1,89 +1,90 @@
 package list
 
+import (
+	"errors"
+
+	"github.com/kirillrogovoy/computer-science/bounds"
+)
+
+var (
+	ErrIndexOutOfRange        = bounds.ErrIndexOutOfRange
+	ErrEmpty                  = bounds.ErrEmpty
+	ErrConcurrentModification = bounds.ErrConcurrentModification
+	// ErrSameList is what the functions moving the nodes of one list into another
+	// panic with when both are the same list, which would link its nodes into themselves
+	ErrSameList = errors.New("tried to move the nodes of a list into itself")
+)
+
+type IndexError = bounds.IndexError
+
 type node struct {
 	value int
 	next  *node
 }
 
-type list struct {
+type List struct {
 	first *node
 	size  int
 	last  *node
+	// mods counts structural modifications so that iterators can fail fast
+	mods int
 }
 
-func New() *list {
-	return &list{nil, 0, nil}
+func New() *List {
+	return &List{nil, 0, nil, 0}
 }
 
-func Size(l *list) int {
+func Size(l *List) int {
 	return l.size
 }
 
-func Empty(l *list) bool {
+func Empty(l *List) bool {
 	return l.size == 0
 }
 
-func Insert(l *list, index int, value int) bool {
-	size := Size(l)
-	newNode := &node{value, nil}
+func Insert(l *List, index int, value int) bool {
+	return TryInsert(l, index, value) == nil
+}
 
-	if index < 0 {
-		index = index + size
-	}
+func TryInsert(l *List, index int, value int) error {
+	size := Size(l)
 
-	if index > size {
-		return false
+	// allow negative index, means "from the end"
+	index, err := bounds.NormalizeInsert(index, size)
+	if err != nil {
+		return err
 	}
 
 	if index == 0 {
-		prevFirst := l.first
-		l.first = newNode
-		if prevFirst != nil {
-			l.first.next = prevFirst
-		}
-
-		if l.last == nil {
-			l.last = l.first
-		}
+		insertAfter(l, nil, value)
 	} else {
 		prev, _ := nodeAt(l, index-1)
-		next, ok := nodeAt(l, index)
-
-		prev.next = newNode
-		if ok {
-			newNode.next = next
-		} else {
-			l.last = newNode
-		}
+		insertAfter(l, prev, value)
 	}
 
-	l.size++
-	return true
+	return nil
 }
 
-func PushFront(l *list, value int) {
+func PushFront(l *List, value int) {
 	Insert(l, 0, value)
 }
 
-func PushBack(l *list, value int) {
+func PushBack(l *List, value int) {
 	Insert(l, Size(l), value)
 }
 
-func nodeAt(l *list, index int) (*node, bool) {
+func nodeAt(l *List, index int) (*node, error) {
 	size := Size(l)
 
 	// allow negative index, means "from the end"
-	if index < 0 {
-		index = size + index - 1
-	}
-
-	if index >= size || index < 0 {
-		return nil, false
+	index, err := bounds.Normalize(index, size)
+	if err != nil {
+		return nil, err
 	}
 
 	// if it's the last element
 	if index == size-1 {
-		return l.last, true
+		return l.last, nil
 	}
 
 	cur := l.first
@@ -91,98 +92,117 @@ func nodeAt(l *list, index int) (*node, bool) {
 		cur = cur.next
 	}
 
-	return cur, true
+	return cur, nil
 }
 
-func At(l *list, index int) (int, bool) {
-	node, ok := nodeAt(l, index)
-	if ok {
-		return node.value, true
-	} else {
-		return 0, false
+func At(l *List, index int) (int, bool) {
+	value, err := TryAt(l, index)
+	return value, err == nil
+}
+
+func TryAt(l *List, index int) (int, error) {
+	node, err := nodeAt(l, index)
+	if err != nil {
+		return 0, err
 	}
+
+	return node.value, nil
 }
 
-func Remove(l *list, index int) bool {
-	size := Size(l)
+func Remove(l *List, index int) bool {
+	return TryRemove(l, index) == nil
+}
 
-	if index >= size {
-		return false
-	}
+func TryRemove(l *List, index int) error {
+	size := Size(l)
 
-	if size == 1 {
-		l.first = nil
-		l.last = nil
-		l.size = 0
-		return true
+	// allow negative index, means "from the end"
+	index, err := bounds.Normalize(index, size)
+	if err != nil {
+		return err
 	}
 
 	if index == 0 {
-		l.first = l.first.next
+		removeAfter(l, nil)
 	} else {
 		prev, _ := nodeAt(l, index-1)
-		prev.next = prev.next.next
-
-		// update l.last when asked to remove the last element
-		if index == size-1 {
-			l.last = prev
-		}
+		removeAfter(l, prev)
 	}
 
-	l.size--
+	return nil
+}
 
-	return true
+func PopBack(l *List) (int, bool) {
+	result, err := TryPopBack(l)
+	return result, err == nil
 }
 
-func PopBack(l *list) (int, bool) {
-	result, ok := Back(l)
+func TryPopBack(l *List) (int, error) {
+	result, err := TryBack(l)
 
-	if ok {
-		Remove(l, Size(l)-1)
+	if err == nil {
+		err = TryRemove(l, Size(l)-1)
 	}
 
-	return result, ok
+	return result, err
+}
+
+func PopFront(l *List) (int, bool) {
+	result, err := TryPopFront(l)
+	return result, err == nil
 }
 
-func PopFront(l *list) (int, bool) {
-	result, ok := Front(l)
+func TryPopFront(l *List) (int, error) {
+	result, err := TryFront(l)
 
-	if ok {
-		Remove(l, 0)
+	if err == nil {
+		err = TryRemove(l, 0)
 	}
 
-	return result, ok
+	return result, err
 }
 
-func RemoveItem(l *list, value int) bool {
-	cur := l.first
-	for i := 0; i < Size(l); i++ {
+func RemoveItem(l *List, value int) bool {
+	// remember the previous node so that removal doesn't need another walk
+	var prev *node
+	for cur := l.first; cur != nil; cur = cur.next {
 		if cur.value == value {
-			return Remove(l, i)
+			removeAfter(l, prev)
+			return true
 		}
-		cur = cur.next
+		prev = cur
 	}
 
 	return false
 }
 
-func Front(l *list) (int, bool) {
+func Front(l *List) (int, bool) {
+	result, err := TryFront(l)
+	return result, err == nil
+}
+
+func TryFront(l *List) (int, error) {
 	if Size(l) == 0 {
-		return 0, false
+		return 0, bounds.ErrEmpty
 	} else {
-		return l.first.value, true
+		return l.first.value, nil
 	}
 }
 
-func Back(l *list) (int, bool) {
+func Back(l *List) (int, bool) {
+	result, err := TryBack(l)
+	return result, err == nil
+}
+
+func TryBack(l *List) (int, error) {
 	if Size(l) == 0 {
-		return 0, false
+		return 0, bounds.ErrEmpty
 	} else {
-		return l.last.value, true
+		return l.last.value, nil
 	}
 }
 
-func Reverse(l *list) {
+func Reverse(l *List) {
 	first := l.first
 	if first == nil {
 		return
@@ -193,6 +213,9 @@ func Reverse(l *list) {
 		return
 	}
 
+	// the old first node becomes the last one and must not point anywhere
+	first.next = nil
+
 	prev := first
 	cur := second
 	for cur != nil {
@@ -203,4 +226,48 @@ func Reverse(l *list) {
 	}
 
 	l.first, l.last = l.last, l.first
+	l.mods++
+}
+
+// insertAfter links a new node after prev, or at the front when prev is nil
+func insertAfter(l *List, prev *node, value int) *node {
+	newNode := &node{value, nil}
+
+	if prev == nil {
+		newNode.next = l.first
+		l.first = newNode
+	} else {
+		newNode.next = prev.next
+		prev.next = newNode
+	}
+
+	if newNode.next == nil {
+		l.last = newNode
+	}
+
+	l.size++
+	l.mods++
+	return newNode
+}
+
+// removeAfter unlinks the node following prev, or the first one when prev is nil
+func removeAfter(l *List, prev *node) *node {
+	var removed *node
+	if prev == nil {
+		removed = l.first
+		l.first = removed.next
+	} else {
+		removed = prev.next
+		prev.next = removed.next
+	}
+
+	// update l.last when asked to remove the last element
+	if removed.next == nil {
+		l.last = prev
+	}
+
+	removed.next = nil
+	l.size--
+	l.mods++
+	return removed
 }
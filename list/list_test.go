@@ -1,6 +1,7 @@
 package list
 
 import (
+	"errors"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -99,15 +100,20 @@ func TestAt(t *testing.T) {
 	require.Equal(t, 1, l.first.value)
 	require.Equal(t, 3, l.last.value)
 
+	// negative indices count from the end, -1 is the last element
 	res, ok = At(l, -1)
 	require.Equal(t, true, ok)
-	require.Equal(t, 2, res)
+	require.Equal(t, 3, res)
 
 	res, ok = At(l, -2)
 	require.Equal(t, true, ok)
-	require.Equal(t, 1, res)
+	require.Equal(t, 2, res)
 
 	res, ok = At(l, -3)
+	require.Equal(t, true, ok)
+	require.Equal(t, 1, res)
+
+	res, ok = At(l, -4)
 	require.Equal(t, false, ok)
 }
 
@@ -376,4 +382,120 @@ func TestReverse(t *testing.T) {
 	require.Equal(t, 1, l.first.next.value)
 	require.Equal(t, 2, l.first.next.next.value)
 	require.Equal(t, 2, l.last.value)
+	require.Nil(t, l.last.next)
+}
+
+func TestTryInsert(t *testing.T) {
+	l := New()
+
+	require.Equal(t, &IndexError{Index: 1, Size: 0}, TryInsert(l, 1, 1))
+	require.Equal(t, &IndexError{Index: -1, Size: 0}, TryInsert(l, -1, 1))
+	require.Equal(t, 0, Size(l))
+
+	require.Nil(t, TryInsert(l, 0, 1))
+	require.Nil(t, TryInsert(l, 1, 2))
+	require.Equal(t, 2, Size(l))
+}
+
+func TestTryAt(t *testing.T) {
+	l := New()
+
+	_, err := TryAt(l, 0)
+	require.Equal(t, true, errors.Is(err, ErrIndexOutOfRange))
+	require.Equal(t, &IndexError{Index: 0, Size: 0}, err)
+
+	PushBack(l, 1)
+
+	res, err := TryAt(l, 0)
+	require.Nil(t, err)
+	require.Equal(t, 1, res)
+}
+
+func TestTryRemove(t *testing.T) {
+	l := New()
+
+	require.Equal(t, &IndexError{Index: 0, Size: 0}, TryRemove(l, 0))
+
+	PushBack(l, 1)
+
+	require.Equal(t, &IndexError{Index: 1, Size: 1}, TryRemove(l, 1))
+	require.Nil(t, TryRemove(l, 0))
+	require.Equal(t, 0, Size(l))
+}
+
+func TestTryPop(t *testing.T) {
+	l := New()
+
+	_, err := TryPopBack(l)
+	require.Equal(t, ErrEmpty, err)
+	_, err = TryPopFront(l)
+	require.Equal(t, ErrEmpty, err)
+	_, err = TryFront(l)
+	require.Equal(t, ErrEmpty, err)
+	_, err = TryBack(l)
+	require.Equal(t, ErrEmpty, err)
+
+	PushBack(l, 1)
+	PushBack(l, 2)
+
+	res, err := TryFront(l)
+	require.Nil(t, err)
+	require.Equal(t, 1, res)
+
+	res, err = TryBack(l)
+	require.Nil(t, err)
+	require.Equal(t, 2, res)
+
+	res, err = TryPopBack(l)
+	require.Nil(t, err)
+	require.Equal(t, 2, res)
+
+	res, err = TryPopFront(l)
+	require.Nil(t, err)
+	require.Equal(t, 1, res)
+	require.Equal(t, 0, Size(l))
+}
+
+func TestRemoveNegative(t *testing.T) {
+	l := New()
+	PushBack(l, 1)
+	PushBack(l, 2)
+	PushBack(l, 3)
+	PushBack(l, 4)
+
+	require.Equal(t, false, Remove(l, -5))
+
+	// Remove the last element, the list should be [1, 2, 3]
+	require.Equal(t, true, Remove(l, -1))
+	require.Equal(t, 3, l.last.value)
+	require.Equal(t, 3, Size(l))
+
+	// Remove the first element, the list should be [2, 3]
+	require.Equal(t, true, Remove(l, -3))
+	require.Equal(t, 2, l.first.value)
+	require.Equal(t, 2, Size(l))
+
+	require.Equal(t, &IndexError{Index: -3, Size: 2}, TryRemove(l, -3))
+}
+
+func TestInsertNegative(t *testing.T) {
+	l := New()
+	PushBack(l, 1)
+	PushBack(l, 3)
+
+	// -1 means "before the last element", like in Python
+	require.Equal(t, true, Insert(l, -1, 2))
+	require.Equal(t, 3, l.last.value)
+
+	require.Equal(t, true, Insert(l, -3, 0))
+	require.Equal(t, 0, l.first.value)
+	require.Equal(t, 4, Size(l))
+
+	require.Equal(t, false, Insert(l, -5, 8))
+	require.Equal(t, 4, Size(l))
+
+	for i, expected := range []int{0, 1, 2, 3} {
+		res, _ := At(l, i)
+		require.Equal(t, expected, res)
+	}
 }
@@ -0,0 +1,45 @@
+package list
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestMethods(t *testing.T) {
+	l := New()
+	require.Equal(t, true, l.Empty())
+
+	l.PushBack(2)
+	l.PushFront(1)
+	require.Equal(t, true, l.Insert(2, 3))
+	require.Equal(t, 3, l.Size())
+
+	res, ok := l.At(1)
+	require.Equal(t, true, ok)
+	require.Equal(t, 2, res)
+
+	res, _ = l.Front()
+	require.Equal(t, 1, res)
+	res, _ = l.Back()
+	require.Equal(t, 3, res)
+
+	l.Reverse()
+	res, _ = l.PopFront()
+	require.Equal(t, 3, res)
+	res, _ = l.PopBack()
+	require.Equal(t, 1, res)
+
+	require.Equal(t, true, l.RemoveItem(2))
+	require.Equal(t, false, l.Remove(0))
+	require.Equal(t, true, l.Empty())
+}
+
+type container struct {
+	items *List
+}
+
+func TestEmbedding(t *testing.T) {
+	c := container{New()}
+	c.items.PushBack(1)
+	require.Equal(t, 1, Size(c.items))
+}
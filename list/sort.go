@@ -0,0 +1,148 @@
+package list
+
+// Sort orders the list with bottom-up merge sort. It relinks the existing nodes,
+// so it takes O(n log n) time, O(1) memory and keeps equal values in order
+func Sort(l *List) {
+	size := Size(l)
+	if size < 2 {
+		return
+	}
+
+	// merge pairs of sorted runs of width nodes until there's a single run
+	for width := 1; width < size; width *= 2 {
+		var first, last *node
+
+		cur := l.first
+		for cur != nil {
+			left := cur
+			right := cut(left, width)
+			cur = cut(right, width)
+
+			head, tail := merge(left, right)
+			if last == nil {
+				first = head
+			} else {
+				last.next = head
+			}
+			last = tail
+		}
+
+		l.first, l.last = first, last
+	}
+
+	l.mods++
+}
+
+func IsSorted(l *List) bool {
+	for cur := l.first; cur != nil && cur.next != nil; cur = cur.next {
+		if cur.value > cur.next.value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MergeSorted moves the nodes of two sorted lists into a new sorted one.
+// a and b are left empty
+func MergeSorted(a *List, b *List) *List {
+	if a == b {
+		panic(ErrSameList)
+	}
+
+	first, last := merge(a.first, b.first)
+	result := &List{first, a.size + b.size, last, 0}
+
+	for _, l := range []*List{a, b} {
+		l.first = nil
+		l.last = nil
+		l.size = 0
+		l.mods++
+	}
+
+	return result
+}
+
+// InsertSorted inserts value after the equal ones in a sorted list and returns its index
+func InsertSorted(l *List, value int) int {
+	var prev *node
+	index := 0
+	for cur := l.first; cur != nil && cur.value <= value; cur = cur.next {
+		prev = cur
+		index++
+	}
+
+	insertAfter(l, prev, value)
+	return index
+}
+
+// Dedupe leaves only one node out of every run of equal values in a sorted list.
+// It returns how many nodes were removed
+func Dedupe(l *List) int {
+	removed := 0
+	for cur := l.first; cur != nil && cur.next != nil; {
+		if cur.next.value == cur.value {
+			removeAfter(l, cur)
+			removed++
+		} else {
+			cur = cur.next
+		}
+	}
+
+	return removed
+}
+
+// cut detaches the chain after n nodes and returns the detached part
+func cut(head *node, n int) *node {
+	for i := 1; head != nil && i < n; i++ {
+		head = head.next
+	}
+
+	if head == nil {
+		return nil
+	}
+
+	rest := head.next
+	head.next = nil
+	return rest
+}
+
+// merge relinks two sorted chains into one and returns its first and last nodes
+func merge(a *node, b *node) (*node, *node) {
+	var first, last *node
+
+	for a != nil && b != nil {
+		var next *node
+
+		// take from a on ties to keep the sort stable
+		if b.value < a.value {
+			next, b = b, b.next
+		} else {
+			next, a = a, a.next
+		}
+
+		if last == nil {
+			first = next
+		} else {
+			last.next = next
+		}
+		last = next
+	}
+
+	rest := a
+	if rest == nil {
+		rest = b
+	}
+
+	if last == nil {
+		first = rest
+	} else {
+		last.next = rest
+	}
+
+	for ; rest != nil; rest = rest.next {
+		last = rest
+	}
+
+	return first, last
+}
@@ -0,0 +1,126 @@
+package list
+
+import (
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// requireList checks the values along with the size and l.last bookkeeping
+func requireList(t *testing.T, l *List, expected []int) {
+	values := slices.Collect(Values(l))
+	if values == nil {
+		values = []int{}
+	}
+
+	require.Equal(t, expected, values)
+	require.Equal(t, len(expected), Size(l))
+
+	if len(expected) == 0 {
+		require.Nil(t, l.first)
+		require.Nil(t, l.last)
+	} else {
+		require.Equal(t, expected[len(expected)-1], l.last.value)
+		require.Nil(t, l.last.next)
+	}
+}
+
+func TestSort(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+
+	for _, size := range []int{0, 1, 2, 3, 5, 8, 17, 100, 1000} {
+		l := New()
+		values := []int{}
+		for i := 0; i < size; i++ {
+			value := random.Intn(size/2 + 1)
+			values = append(values, value)
+			PushBack(l, value)
+		}
+
+		Sort(l)
+		slices.Sort(values)
+
+		requireList(t, l, values)
+		require.Equal(t, true, IsSorted(l))
+	}
+}
+
+func TestSortKeepsNodes(t *testing.T) {
+	l := newList(3, 1, 2)
+	nodes := map[*node]bool{}
+	for cur := l.first; cur != nil; cur = cur.next {
+		nodes[cur] = true
+	}
+
+	l.Sort()
+
+	for cur := l.first; cur != nil; cur = cur.next {
+		require.Equal(t, true, nodes[cur], "Sort() should relink the nodes instead of allocating")
+	}
+	requireList(t, l, []int{1, 2, 3})
+}
+
+func TestSortAllocations(t *testing.T) {
+	l := New()
+	for i := 1000; i > 0; i-- {
+		PushBack(l, i)
+	}
+
+	allocations := testing.AllocsPerRun(1, func() {
+		Reverse(l)
+		Sort(l)
+	})
+	require.Equal(t, 0.0, allocations)
+}
+
+func TestIsSorted(t *testing.T) {
+	require.Equal(t, true, IsSorted(New()))
+	require.Equal(t, true, IsSorted(newList(1)))
+	require.Equal(t, true, IsSorted(newList(1, 1, 2)))
+	require.Equal(t, false, newList(2, 1).IsSorted())
+}
+
+func TestMergeSorted(t *testing.T) {
+	a := newList(1, 3, 5, 7)
+	b := newList(2, 3, 4, 10, 11)
+
+	merged := MergeSorted(a, b)
+	requireList(t, merged, []int{1, 2, 3, 3, 4, 5, 7, 10, 11})
+	requireList(t, a, []int{})
+	requireList(t, b, []int{})
+
+	requireList(t, MergeSorted(New(), New()), []int{})
+	requireList(t, MergeSorted(newList(1, 2), New()), []int{1, 2})
+	requireList(t, MergeSorted(New(), newList(1, 2)), []int{1, 2})
+
+	l := newList(1, 2, 3)
+	require.PanicsWithValue(t, ErrSameList, func() { MergeSorted(l, l) })
+	requireList(t, l, []int{1, 2, 3})
+}
+
+func TestInsertSorted(t *testing.T) {
+	l := New()
+
+	require.Equal(t, 0, InsertSorted(l, 5))
+	require.Equal(t, 0, InsertSorted(l, 1))
+	require.Equal(t, 2, InsertSorted(l, 9))
+	require.Equal(t, 2, l.InsertSorted(5))
+	require.Equal(t, 1, InsertSorted(l, 3))
+
+	requireList(t, l, []int{1, 3, 5, 5, 9})
+}
+
+func TestDedupe(t *testing.T) {
+	l := newList(1, 1, 2, 3, 3, 3)
+	require.Equal(t, 3, Dedupe(l))
+	requireList(t, l, []int{1, 2, 3})
+
+	l = newList(4, 4, 4)
+	require.Equal(t, 2, l.Dedupe())
+	requireList(t, l, []int{4})
+
+	l = New()
+	require.Equal(t, 0, Dedupe(l))
+	requireList(t, l, []int{})
+}
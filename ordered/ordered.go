@@ -0,0 +1,37 @@
+// Package ordered describes what the ordered maps of this repository have in common,
+// so they can be swapped for one another and tested with the same suite
+package ordered
+
+import (
+	"iter"
+)
+
+// OrderedMap is a map which keeps its keys sorted
+type OrderedMap[K, V any] interface {
+	Len() int
+	Empty() bool
+	Get(key K) (V, bool)
+	Contains(key K) bool
+	// Put sets the value of key and tells if the key is new
+	Put(key K, value V) bool
+	// Delete removes key and tells if it was there
+	Delete(key K) bool
+	Min() (K, V, bool)
+	Max() (K, V, bool)
+	// Floor returns the greatest key which is less than or equal to key
+	Floor(key K) (K, V, bool)
+	// Ceiling returns the smallest key which is greater than or equal to key
+	Ceiling(key K) (K, V, bool)
+	// All yields key-value pairs in the order of keys. It and the rest of the iterators
+	// panic with ErrConcurrentModification if the loop body adds or deletes keys.
+	// Updating the value of a key is fine
+	All() iter.Seq2[K, V]
+	Keys() iter.Seq[K]
+	Values() iter.Seq[V]
+}
+
+// Validator is implemented by the maps which can check their own invariants,
+// e.g. the balance of a tree. Validate returns an error describing the first broken one
+type Validator interface {
+	Validate() error
+}
@@ -0,0 +1,283 @@
+// Package orderedtest is a conformance suite for ordered.OrderedMap implementations.
+// It runs the same operation sequences against a map and a sorted model of it,
+// validating the map after every change if it's an ordered.Validator
+package orderedtest
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/kirillrogovoy/computer-science/ordered"
+)
+
+// Run runs the whole suite. new must return an empty map
+func Run(t *testing.T, new func() ordered.OrderedMap[int, int]) {
+	t.Run("PutGet", func(t *testing.T) { testPutGet(t, new()) })
+	t.Run("Delete", func(t *testing.T) { testDelete(t, new()) })
+	t.Run("Ascending", func(t *testing.T) { testSequence(t, new(), ascending(1000)) })
+	t.Run("Descending", func(t *testing.T) { testSequence(t, new(), descending(1000)) })
+	t.Run("ZigZag", func(t *testing.T) { testSequence(t, new(), zigZag(1000)) })
+	t.Run("Random", func(t *testing.T) { testRandom(t, new()) })
+	t.Run("Bounds", func(t *testing.T) { testBounds(t, new()) })
+	t.Run("Iterators", func(t *testing.T) { testIterators(t, new()) })
+}
+
+// model is a sorted slice of keys and a map of their values
+type model struct {
+	keys   []int
+	values map[int]int
+}
+
+func newModel() *model {
+	return &model{[]int{}, map[int]int{}}
+}
+
+func (m *model) put(key, value int) bool {
+	_, exists := m.values[key]
+	m.values[key] = value
+	if !exists {
+		index, _ := slices.BinarySearch(m.keys, key)
+		m.keys = slices.Insert(m.keys, index, key)
+	}
+	return !exists
+}
+
+func (m *model) delete(key int) bool {
+	index, exists := slices.BinarySearch(m.keys, key)
+	if exists {
+		m.keys = slices.Delete(m.keys, index, index+1)
+		delete(m.values, key)
+	}
+	return exists
+}
+
+func validate(t testing.TB, m ordered.OrderedMap[int, int]) {
+	t.Helper()
+	if v, ok := m.(ordered.Validator); ok {
+		if err := v.Validate(); err != nil {
+			t.Fatalf("Validate() = %v", err)
+		}
+	}
+}
+
+// expectMatches compares everything the map exposes with the model
+func expectMatches(t testing.TB, m ordered.OrderedMap[int, int], expected *model) {
+	t.Helper()
+	validate(t, m)
+
+	expectEqual(t, "Len()", len(expected.keys), m.Len())
+	expectEqual(t, "Empty()", len(expected.keys) == 0, m.Empty())
+	expectSlice(t, "Keys()", expected.keys, collectKeys(m))
+
+	for _, key := range expected.keys {
+		value, ok := m.Get(key)
+		expectEqual(t, fmt.Sprintf("Get(%d) found", key), true, ok)
+		expectEqual(t, fmt.Sprintf("Get(%d)", key), expected.values[key], value)
+	}
+
+	minKey, _, ok := m.Min()
+	expectEqual(t, "Min() found", len(expected.keys) > 0, ok)
+	maxKey, _, _ := m.Max()
+	if ok {
+		expectEqual(t, "Min()", expected.keys[0], minKey)
+		expectEqual(t, "Max()", expected.keys[len(expected.keys)-1], maxKey)
+	}
+}
+
+func collectKeys(m ordered.OrderedMap[int, int]) []int {
+	keys := []int{}
+	for key := range m.Keys() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func testPutGet(t testing.TB, m ordered.OrderedMap[int, int]) {
+	expectEqual(t, "Empty()", true, m.Empty())
+	_, ok := m.Get(1)
+	expectEqual(t, "Get(1) found", false, ok)
+
+	expectEqual(t, "Put(2, 20)", true, m.Put(2, 20))
+	expectEqual(t, "Put(1, 10)", true, m.Put(1, 10))
+	expectEqual(t, "Put(2, 200)", false, m.Put(2, 200))
+
+	value, ok := m.Get(2)
+	expectEqual(t, "Get(2) found", true, ok)
+	expectEqual(t, "Get(2)", 200, value)
+	expectEqual(t, "Contains(1)", true, m.Contains(1))
+	expectEqual(t, "Contains(3)", false, m.Contains(3))
+	expectEqual(t, "Len()", 2, m.Len())
+	validate(t, m)
+}
+
+func testDelete(t testing.TB, m ordered.OrderedMap[int, int]) {
+	expectEqual(t, "Delete(1)", false, m.Delete(1))
+
+	expected := newModel()
+	for _, key := range []int{50, 30, 70, 20, 40, 60, 80, 35, 45, 65} {
+		m.Put(key, key)
+		expected.put(key, key)
+	}
+
+	// leaves, inner nodes and the root, in an order that exercises all the cases
+	for _, key := range []int{20, 70, 50, 45, 30, 80, 35, 60, 40, 65} {
+		expectEqual(t, fmt.Sprintf("Delete(%d)", key), true, m.Delete(key))
+		expectEqual(t, fmt.Sprintf("Delete(%d) again", key), false, m.Delete(key))
+		expected.delete(key)
+		expectMatches(t, m, expected)
+	}
+}
+
+func ascending(n int) []int {
+	keys := make([]int, n)
+	for i := range keys {
+		keys[i] = i
+	}
+	return keys
+}
+
+func descending(n int) []int {
+	keys := ascending(n)
+	slices.Reverse(keys)
+	return keys
+}
+
+// zigZag alternates between the smallest and the greatest keys left
+func zigZag(n int) []int {
+	keys := make([]int, 0, n)
+	for lo, hi := 0, n-1; lo <= hi; lo, hi = lo+1, hi-1 {
+		keys = append(keys, lo)
+		if lo != hi {
+			keys = append(keys, hi)
+		}
+	}
+	return keys
+}
+
+// testSequence puts the keys in the given order, then deletes them in the same order
+func testSequence(t testing.TB, m ordered.OrderedMap[int, int], keys []int) {
+	expected := newModel()
+	for i, key := range keys {
+		expectEqual(t, fmt.Sprintf("Put(%d, %d)", key, i), true, m.Put(key, i))
+		expected.put(key, i)
+		validate(t, m)
+	}
+	expectMatches(t, m, expected)
+
+	for _, key := range keys {
+		expectEqual(t, fmt.Sprintf("Delete(%d)", key), true, m.Delete(key))
+		expected.delete(key)
+		validate(t, m)
+	}
+	expectMatches(t, m, expected)
+}
+
+func testRandom(t testing.TB, m ordered.OrderedMap[int, int]) {
+	random := rand.New(rand.NewSource(42))
+	expected := newModel()
+
+	for i := 0; i < 5000; i++ {
+		key := random.Intn(500)
+		if random.Intn(3) > 0 {
+			expectEqual(t, fmt.Sprintf("Put(%d, %d)", key, i), expected.put(key, i), m.Put(key, i))
+		} else {
+			expectEqual(t, fmt.Sprintf("Delete(%d)", key), expected.delete(key), m.Delete(key))
+		}
+
+		validate(t, m)
+		if i%500 == 0 {
+			expectMatches(t, m, expected)
+		}
+	}
+
+	expectMatches(t, m, expected)
+}
+
+func testBounds(t testing.TB, m ordered.OrderedMap[int, int]) {
+	_, _, ok := m.Min()
+	expectEqual(t, "Min() found", false, ok)
+	_, _, ok = m.Max()
+	expectEqual(t, "Max() found", false, ok)
+	_, _, ok = m.Floor(0)
+	expectEqual(t, "Floor(0) found", false, ok)
+	_, _, ok = m.Ceiling(0)
+	expectEqual(t, "Ceiling(0) found", false, ok)
+
+	keys := []int{}
+	for key := 0; key < 200; key += 4 {
+		m.Put(key, -key)
+		keys = append(keys, key)
+	}
+
+	for key := -5; key < 205; key++ {
+		index, found := slices.BinarySearch(keys, key)
+
+		what := fmt.Sprintf("Floor(%d)", key)
+		floor, value, ok := m.Floor(key)
+		expectEqual(t, what+" found", found || index > 0, ok)
+		switch {
+		case found:
+			expectEqual(t, what, key, floor)
+			expectEqual(t, what+" value", -key, value)
+		case index > 0:
+			expectEqual(t, what, keys[index-1], floor)
+		}
+
+		what = fmt.Sprintf("Ceiling(%d)", key)
+		ceiling, _, ok := m.Ceiling(key)
+		expectEqual(t, what+" found", index < len(keys), ok)
+		if index < len(keys) {
+			expectEqual(t, what, keys[index], ceiling)
+		}
+	}
+}
+
+func testIterators(t testing.TB, m ordered.OrderedMap[int, int]) {
+	for _, key := range []int{3, 1, 2} {
+		m.Put(key, key*10)
+	}
+
+	pairs := [][2]int{}
+	for key, value := range m.All() {
+		pairs = append(pairs, [2]int{key, value})
+	}
+	expectSlice(t, "All()", [][2]int{{1, 10}, {2, 20}, {3, 30}}, pairs)
+	expectSlice(t, "Values()", []int{10, 20, 30}, slices.Collect(m.Values()))
+
+	// stopping early
+	for range m.All() {
+		break
+	}
+
+	// updating values while iterating is fine
+	for key, value := range m.All() {
+		m.Put(key, value+1)
+	}
+	expectSlice(t, "Values() after the updates", []int{11, 21, 31}, slices.Collect(m.Values()))
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Keys() should panic when the map is modified during the iteration")
+		}
+	}()
+
+	for key := range m.Keys() {
+		m.Delete(key)
+	}
+}
+
+func expectEqual[V comparable](t testing.TB, what string, expected, actual V) {
+	t.Helper()
+	if actual != expected {
+		t.Fatalf("%s = %v, expected %v", what, actual, expected)
+	}
+}
+
+func expectSlice[V comparable](t testing.TB, what string, expected, actual []V) {
+	t.Helper()
+	if !slices.Equal(actual, expected) {
+		t.Fatalf("%s = %v, expected %v", what, actual, expected)
+	}
+}
@@ -0,0 +1,23 @@
+package queue
+
+// Method forms of the package-level functions
+
+func (q *Queue) Push(value int) {
+	Push(q, value)
+}
+
+func (q *Queue) Pop() (int, error) {
+	return Pop(q)
+}
+
+func (q *Queue) Peek() (int, error) {
+	return Peek(q)
+}
+
+func (q *Queue) Len() int {
+	return Len(q)
+}
+
+func (q *Queue) Empty() bool {
+	return Empty(q)
+}
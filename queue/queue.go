@@ -0,0 +1,117 @@
+package queue
+
+import (
+	"fmt"
+
+	"github.com/kirillrogovoy/computer-science/array"
+	"github.com/kirillrogovoy/computer-science/bounds"
+	"github.com/kirillrogovoy/computer-science/list"
+)
+
+var ErrEmpty = bounds.ErrEmpty
+
+// Backing selects the container a Queue keeps its values in
+type Backing int
+
+const (
+	// ArrayBacked pushes at the end of the dynamic array and pops from its beginning,
+	// which shifts every value and thus takes O(n)
+	ArrayBacked Backing = iota
+	// ListBacked pushes at the back of the linked list and pops from its front, both O(1)
+	ListBacked
+)
+
+// store is the part of a container a queue needs
+type store interface {
+	push(value int)
+	pop() (int, error)
+	peek() (int, error)
+	len() int
+}
+
+type Queue struct {
+	store store
+}
+
+func New(backing Backing) *Queue {
+	switch backing {
+	case ArrayBacked:
+		return &Queue{arrayStore{array.Create(0)}}
+	case ListBacked:
+		return &Queue{listStore{list.New()}}
+	default:
+		panic(fmt.Sprintf("Unknown queue backing %d", backing))
+	}
+}
+
+// Push adds the value to the back of the queue
+func Push(q *Queue, value int) {
+	q.store.push(value)
+}
+
+// Pop removes the value at the front of the queue, the one pushed the earliest
+func Pop(q *Queue) (int, error) {
+	return q.store.pop()
+}
+
+// Peek returns the value Pop would return without removing it
+func Peek(q *Queue) (int, error) {
+	return q.store.peek()
+}
+
+func Len(q *Queue) int {
+	return q.store.len()
+}
+
+func Empty(q *Queue) bool {
+	return Len(q) == 0
+}
+
+type arrayStore struct {
+	arr *array.Array
+}
+
+func (a arrayStore) push(value int) {
+	array.Push(a.arr, value)
+}
+
+func (a arrayStore) pop() (int, error) {
+	value, err := a.peek()
+	if err != nil {
+		return 0, err
+	}
+
+	return value, array.TryDelete(a.arr, 0)
+}
+
+func (a arrayStore) peek() (int, error) {
+	if array.IsEmpty(a.arr) {
+		return 0, bounds.ErrEmpty
+	}
+
+	return array.TryAt(a.arr, 0)
+}
+
+func (a arrayStore) len() int {
+	return array.Size(a.arr)
+}
+
+type listStore struct {
+	l *list.List
+}
+
+func (s listStore) push(value int) {
+	list.PushBack(s.l, value)
+}
+
+func (s listStore) pop() (int, error) {
+	return list.TryPopFront(s.l)
+}
+
+func (s listStore) peek() (int, error) {
+	return list.TryFront(s.l)
+}
+
+func (s listStore) len() int {
+	return list.Size(s.l)
+}
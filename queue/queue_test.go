@@ -0,0 +1,110 @@
+package queue
+
+import (
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"testing"
+)
+
+var backings = map[string]Backing{
+	"array": ArrayBacked,
+	"list":  ListBacked,
+}
+
+func TestNewPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("New() should panic on an unknown backing")
+		}
+	}()
+
+	New(Backing(42))
+}
+
+func TestQueue(t *testing.T) {
+	for name, backing := range backings {
+		t.Run(name, func(t *testing.T) {
+			q := New(backing)
+			require.Equal(t, 0, Len(q))
+			require.Equal(t, true, Empty(q))
+
+			_, err := Pop(q)
+			require.Equal(t, ErrEmpty, err)
+			_, err = Peek(q)
+			require.Equal(t, ErrEmpty, err)
+
+			for i := 1; i <= 100; i++ {
+				Push(q, i)
+				require.Equal(t, i, Len(q))
+
+				// the front stays the same
+				front, err := Peek(q)
+				require.Nil(t, err)
+				require.Equal(t, 1, front)
+			}
+
+			// first in, first out
+			for i := 1; i <= 100; i++ {
+				value, err := Pop(q)
+				require.Nil(t, err)
+				require.Equal(t, i, value)
+				require.Equal(t, 100-i, Len(q))
+			}
+
+			require.Equal(t, true, Empty(q))
+			_, err = Pop(q)
+			require.Equal(t, ErrEmpty, err)
+		})
+	}
+}
+
+func TestInterleaved(t *testing.T) {
+	for _, backing := range backings {
+		q := New(backing)
+		Push(q, 1)
+		Push(q, 2)
+		value, _ := Pop(q)
+		require.Equal(t, 1, value)
+
+		Push(q, 3)
+		value, _ = Pop(q)
+		require.Equal(t, 2, value)
+		value, _ = Pop(q)
+		require.Equal(t, 3, value)
+		require.Equal(t, true, Empty(q))
+	}
+}
+
+func TestMethods(t *testing.T) {
+	for _, backing := range backings {
+		q := New(backing)
+		q.Push(1)
+		q.Push(2)
+
+		front, _ := q.Peek()
+		require.Equal(t, 1, front)
+		require.Equal(t, 2, q.Len())
+
+		value, _ := q.Pop()
+		require.Equal(t, 1, value)
+		require.Equal(t, false, q.Empty())
+	}
+}
+
+func BenchmarkPushPop(b *testing.B) {
+	for name, backing := range backings {
+		for _, size := range []int{10, 1000, 10000} {
+			b.Run(name+"/"+strconv.Itoa(size), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					q := New(backing)
+					for j := 0; j < size; j++ {
+						Push(q, j)
+					}
+					for j := 0; j < size; j++ {
+						Pop(q)
+					}
+				}
+			})
+		}
+	}
+}
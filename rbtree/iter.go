@@ -0,0 +1,35 @@
+package rbtree
+
+import (
+	"iter"
+
+	"github.com/kirillrogovoy/computer-science/internal/bintree"
+)
+
+// All yields key-value pairs in the order of keys, failing fast as ordered.OrderedMap describes
+func All[K, V any](t *Tree[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		mods := t.mods
+		bintree.InOrder[K, V](t.root, func(key K, value V) bool {
+			if !yield(key, value) {
+				return false
+			}
+			checkMods(t, mods)
+			return true
+		})
+	}
+}
+
+func Keys[K, V any](t *Tree[K, V]) iter.Seq[K] {
+	return bintree.Keys(All(t))
+}
+
+func Values[K, V any](t *Tree[K, V]) iter.Seq[V] {
+	return bintree.Values(All(t))
+}
+
+func checkMods[K, V any](t *Tree[K, V], mods int) {
+	if t.mods != mods {
+		panic(ErrConcurrentModification)
+	}
+}
@@ -0,0 +1,389 @@
+// Package rbtree is an ordered map on a left-leaning red-black tree, Sedgewick's
+// take on red-black trees. It's a binary encoding of a 2-3 tree: a red link glues
+// a node to its parent into a 3-node, and red links only lean left. Since every
+// path from the root has the same number of black links, the height stays
+// below 2 log n and every operation is O(log n) whatever the order of keys
+package rbtree
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/kirillrogovoy/computer-science/bounds"
+	"github.com/kirillrogovoy/computer-science/internal/bintree"
+	"github.com/kirillrogovoy/computer-science/ordered"
+)
+
+var ErrConcurrentModification = bounds.ErrConcurrentModification
+
+const (
+	red   = true
+	black = false
+)
+
+type node[K, V any] struct {
+	key   K
+	value V
+	left  *node[K, V]
+	right *node[K, V]
+	// color is the color of the link from the parent
+	color bool
+}
+
+// The accessors let the walks shared by the trees in internal/bintree read nodes
+
+func (n *node[K, V]) Key() K {
+	return n.key
+}
+
+func (n *node[K, V]) Value() V {
+	return n.value
+}
+
+func (n *node[K, V]) Left() *node[K, V] {
+	return n.left
+}
+
+func (n *node[K, V]) Right() *node[K, V] {
+	return n.right
+}
+
+type Tree[K, V any] struct {
+	root    *node[K, V]
+	size    int
+	compare func(a, b K) int
+	// mods counts structural modifications so that iterators can fail fast
+	mods int
+}
+
+var _ ordered.OrderedMap[int, int] = (*Tree[int, int])(nil)
+var _ ordered.Validator = (*Tree[int, int])(nil)
+
+// New returns a tree of keys which can be compared with <, like ints
+func New[K cmp.Ordered, V any]() *Tree[K, V] {
+	return NewFunc[K, V](cmp.Compare[K])
+}
+
+// NewFunc returns a tree ordering keys with compare, so they can be of any type
+func NewFunc[K, V any](compare func(a, b K) int) *Tree[K, V] {
+	return &Tree[K, V]{nil, 0, compare, 0}
+}
+
+func Len[K, V any](t *Tree[K, V]) int {
+	return t.size
+}
+
+func Empty[K, V any](t *Tree[K, V]) bool {
+	return t.size == 0
+}
+
+// Height returns the number of nodes on the longest path from the root to a leaf,
+// 0 for an empty tree
+func Height[K, V any](t *Tree[K, V]) int {
+	return height(t.root)
+}
+
+func height[K, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+
+	return 1 + max(height(n.left), height(n.right))
+}
+
+func find[K, V any](t *Tree[K, V], key K) *node[K, V] {
+	n := t.root
+	for n != nil {
+		c := t.compare(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+
+	return nil
+}
+
+func Get[K, V any](t *Tree[K, V], key K) (V, bool) {
+	n := find(t, key)
+	if n == nil {
+		var zero V
+		return zero, false
+	}
+
+	return n.value, true
+}
+
+func Contains[K, V any](t *Tree[K, V], key K) bool {
+	return find(t, key) != nil
+}
+
+// Put sets the value of key and tells if the key is new
+func Put[K, V any](t *Tree[K, V], key K, value V) bool {
+	var added bool
+	t.root, added = put(t, t.root, key, value)
+	t.root.color = black
+
+	if added {
+		t.size++
+		t.mods++
+	}
+
+	return added
+}
+
+func put[K, V any](t *Tree[K, V], n *node[K, V], key K, value V) (*node[K, V], bool) {
+	// a new node is always glued to its parent with a red link
+	if n == nil {
+		return &node[K, V]{key: key, value: value, color: red}, true
+	}
+
+	var added bool
+	c := t.compare(key, n.key)
+	switch {
+	case c < 0:
+		n.left, added = put(t, n.left, key, value)
+	case c > 0:
+		n.right, added = put(t, n.right, key, value)
+	default:
+		n.value = value
+		return n, false
+	}
+
+	return fixUp(n), added
+}
+
+// Delete removes key and tells if it was there
+func Delete[K, V any](t *Tree[K, V], key K) bool {
+	if !Contains(t, key) {
+		return false
+	}
+
+	// the way down keeps the current node out of a 2-node, which needs a red link
+	// to borrow from. If both children are black, pretend the root is a 3-node
+	if !isRed(t.root.left) && !isRed(t.root.right) {
+		t.root.color = red
+	}
+
+	t.root = remove(t, t.root, key)
+	if t.root != nil {
+		t.root.color = black
+	}
+
+	t.size--
+	t.mods++
+	return true
+}
+
+// remove deletes key, which must be in the subtree of n
+func remove[K, V any](t *Tree[K, V], n *node[K, V], key K) *node[K, V] {
+	if t.compare(key, n.key) < 0 {
+		if !isRed(n.left) && !isRed(n.left.left) {
+			n = moveRedLeft(n)
+		}
+		n.left = remove(t, n.left, key)
+		return fixUp(n)
+	}
+
+	if isRed(n.left) {
+		n = rotateRight(n)
+	}
+
+	// a leaf at the bottom is red by now, so it can simply go
+	if t.compare(key, n.key) == 0 && n.right == nil {
+		return nil
+	}
+
+	if !isRed(n.right) && !isRed(n.right.left) {
+		n = moveRedRight(n)
+	}
+
+	if t.compare(key, n.key) == 0 {
+		// the successor takes the place of n
+		var successor *node[K, V]
+		n.right, successor = removeMin(n.right)
+		successor.left, successor.right, successor.color = n.left, n.right, n.color
+		n = successor
+	} else {
+		n.right = remove(t, n.right, key)
+	}
+
+	return fixUp(n)
+}
+
+// removeMin unlinks the leftmost node under n and returns what's left and that node
+func removeMin[K, V any](n *node[K, V]) (*node[K, V], *node[K, V]) {
+	if n.left == nil {
+		return nil, n
+	}
+
+	if !isRed(n.left) && !isRed(n.left.left) {
+		n = moveRedLeft(n)
+	}
+
+	var min *node[K, V]
+	n.left, min = removeMin(n.left)
+	return fixUp(n), min
+}
+
+func isRed[K, V any](n *node[K, V]) bool {
+	return n != nil && n.color == red
+}
+
+// rotateLeft turns a right-leaning red link into a left-leaning one
+func rotateLeft[K, V any](n *node[K, V]) *node[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+
+	r.color = n.color
+	n.color = red
+	return r
+}
+
+// rotateRight turns a left-leaning red link into a right-leaning one
+func rotateRight[K, V any](n *node[K, V]) *node[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+
+	l.color = n.color
+	n.color = red
+	return l
+}
+
+// flipColors splits a temporary 4-node by passing the red links of the children
+// up to the parent, or does the opposite on the way down in Delete
+func flipColors[K, V any](n *node[K, V]) {
+	n.color = !n.color
+	n.left.color = !n.left.color
+	n.right.color = !n.right.color
+}
+
+// moveRedLeft makes n.left or one of its children red, assuming n is red
+// and both n.left and n.left.left are black
+func moveRedLeft[K, V any](n *node[K, V]) *node[K, V] {
+	flipColors(n)
+	if isRed(n.right.left) {
+		n.right = rotateRight(n.right)
+		n = rotateLeft(n)
+		flipColors(n)
+	}
+
+	return n
+}
+
+// moveRedRight makes n.right or one of its children red, assuming n is red
+// and both n.right and n.right.left are black
+func moveRedRight[K, V any](n *node[K, V]) *node[K, V] {
+	flipColors(n)
+	if isRed(n.left.left) {
+		n = rotateRight(n)
+		flipColors(n)
+	}
+
+	return n
+}
+
+// fixUp restores the invariants on the way back up: no right-leaning red links,
+// no two red links in a row and no node with two red links
+func fixUp[K, V any](n *node[K, V]) *node[K, V] {
+	if isRed(n.right) && !isRed(n.left) {
+		n = rotateLeft(n)
+	}
+
+	if isRed(n.left) && isRed(n.left.left) {
+		n = rotateRight(n)
+	}
+
+	if isRed(n.left) && isRed(n.right) {
+		flipColors(n)
+	}
+
+	return n
+}
+
+// Min returns the smallest key and its value
+func Min[K, V any](t *Tree[K, V]) (K, V, bool) {
+	return bintree.Min[K, V](t.root)
+}
+
+// Max returns the greatest key and its value
+func Max[K, V any](t *Tree[K, V]) (K, V, bool) {
+	return bintree.Max[K, V](t.root)
+}
+
+// Floor returns the greatest key which is less than or equal to key
+func Floor[K, V any](t *Tree[K, V], key K) (K, V, bool) {
+	return bintree.Floor[K, V](t.root, key, t.compare)
+}
+
+// Ceiling returns the smallest key which is greater than or equal to key
+func Ceiling[K, V any](t *Tree[K, V], key K) (K, V, bool) {
+	return bintree.Ceiling[K, V](t.root, key, t.compare)
+}
+
+// Validate checks that the keys are ordered, that the root is black, that red links
+// lean left and never come two in a row and that every path from the root to
+// a leaf has the same number of black links
+func Validate[K, V any](t *Tree[K, V]) error {
+	if isRed(t.root) {
+		return fmt.Errorf("the root is red")
+	}
+
+	count, _, err := validate(t, t.root, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if count != t.size {
+		return fmt.Errorf("the tree has %d nodes, but its size is %d", count, t.size)
+	}
+
+	return nil
+}
+
+// validate checks the subtree of n, whose keys must be strictly between the keys of lo
+// and hi if those aren't nil. It returns the number of nodes in it and its black height
+func validate[K, V any](t *Tree[K, V], n, lo, hi *node[K, V]) (int, int, error) {
+	if n == nil {
+		return 0, 0, nil
+	}
+
+	if (lo != nil && t.compare(n.key, lo.key) <= 0) || (hi != nil && t.compare(n.key, hi.key) >= 0) {
+		return 0, 0, fmt.Errorf("the key %v is out of order", n.key)
+	}
+
+	if isRed(n.right) {
+		return 0, 0, fmt.Errorf("the node %v has a red right link", n.key)
+	}
+
+	if isRed(n) && isRed(n.left) {
+		return 0, 0, fmt.Errorf("the node %v and its left child are both red", n.key)
+	}
+
+	left, leftBlack, err := validate(t, n.left, lo, n)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	right, rightBlack, err := validate(t, n.right, n, hi)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if leftBlack != rightBlack {
+		return 0, 0, fmt.Errorf("the subtrees of %v have the black heights %d and %d", n.key, leftBlack, rightBlack)
+	}
+
+	blackHeight := leftBlack
+	if !isRed(n) {
+		blackHeight++
+	}
+
+	return 1 + left + right, blackHeight, nil
+}
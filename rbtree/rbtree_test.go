@@ -0,0 +1,101 @@
+package rbtree
+
+import (
+	"cmp"
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/kirillrogovoy/computer-science/ordered"
+	"github.com/kirillrogovoy/computer-science/ordered/orderedtest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConformance(t *testing.T) {
+	orderedtest.Run(t, func() ordered.OrderedMap[int, int] {
+		return New[int, int]()
+	})
+}
+
+func TestLeansLeft(t *testing.T) {
+	tree := New[int, int]()
+	tree.Put(1, 1)
+	tree.Put(2, 2)
+
+	// 2 is put to the right of 1 and then rotated so that the red link leans left
+	require.Equal(t, 2, tree.root.key)
+	require.Equal(t, 1, tree.root.left.key)
+	require.Equal(t, red, tree.root.left.color)
+	require.Equal(t, black, tree.root.color)
+
+	// the third key makes a 4-node which is split, leaving all links black
+	tree.Put(3, 3)
+	require.Equal(t, 2, tree.root.key)
+	require.Equal(t, black, tree.root.left.color)
+	require.Equal(t, black, tree.root.right.color)
+}
+
+func TestHeightOnSortedInput(t *testing.T) {
+	tree := New[int, int]()
+	for key := 0; key < 100000; key++ {
+		tree.Put(key, key)
+	}
+
+	require.LessOrEqual(t, tree.Height(), int(2*math.Log2(float64(tree.Len()))))
+	require.NoError(t, tree.Validate())
+
+	// deleting from one side doesn't unbalance it either
+	random := rand.New(rand.NewSource(1))
+	for key := 0; key < 90000; key++ {
+		tree.Delete(random.Intn(100000))
+	}
+	require.LessOrEqual(t, tree.Height(), int(2*math.Log2(float64(tree.Len()))))
+	require.NoError(t, tree.Validate())
+}
+
+func TestValidate(t *testing.T) {
+	tree := New[int, int]()
+	for key := range 7 {
+		tree.Put(key, key)
+	}
+	require.NoError(t, Validate(tree))
+
+	tree.root.color = red
+	require.EqualError(t, Validate(tree), "the root is red")
+	tree.root.color = black
+
+	tree.root.right.key = -1
+	require.EqualError(t, Validate(tree), "the key -1 is out of order")
+	tree.root.right.key = 5
+
+	tree.root.right.right.color = red
+	require.EqualError(t, Validate(tree), "the node 5 has a red right link")
+	tree.root.right.right.color = black
+
+	tree.root.left.color = red
+	tree.root.left.left.color = red
+	require.EqualError(t, Validate(tree), "the node 1 and its left child are both red")
+	tree.root.left.left.color = black
+
+	require.EqualError(t, Validate(tree), "the subtrees of 3 have the black heights 1 and 2")
+	tree.root.left.color = black
+
+	tree.size--
+	require.EqualError(t, Validate(tree), "the tree has 7 nodes, but its size is 6")
+}
+
+func TestComparator(t *testing.T) {
+	// case-insensitive keys
+	tree := NewFunc[string, int](func(a, b string) int {
+		return cmp.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	tree.Put("b", 1)
+	tree.Put("A", 2)
+	require.Equal(t, false, tree.Put("B", 3))
+
+	require.Equal(t, []string{"A", "b"}, slices.Collect(tree.Keys()))
+	value, _ := tree.Get("B")
+	require.Equal(t, 3, value)
+}
@@ -0,0 +1,41 @@
+package skiplist
+
+import (
+	"iter"
+)
+
+// All yields the values in order along the bottom level.
+// Like the rest of the iterators, it panics with ErrConcurrentModification
+// if the loop body inserts or deletes values
+func All[T any](s *SkipList[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		mods := s.mods
+		for cur := s.head.next[0]; cur != nil; cur = cur.next[0] {
+			if !yield(cur.value) {
+				return
+			}
+			checkMods(s, mods)
+		}
+	}
+}
+
+// Range yields the values between lo and hi, both inclusive, in order.
+// It searches for lo once and then walks the bottom level, so it's O(log n + k) for k values in it
+func Range[T any](s *SkipList[T], lo, hi T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		mods := s.mods
+		for cur := findPrev(s, lo, nil).next[0]; cur != nil && s.compare(cur.value, hi) <= 0; cur = cur.next[0] {
+			if !yield(cur.value) {
+				return
+			}
+			checkMods(s, mods)
+		}
+	}
+}
+
+// checkMods fails fast when the list was structurally modified since an iterator saw mods
+func checkMods[T any](s *SkipList[T], mods int) {
+	if s.mods != mods {
+		panic(ErrConcurrentModification)
+	}
+}
@@ -0,0 +1,98 @@
+package skiplist
+
+import (
+	"errors"
+	"iter"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAll(t *testing.T) {
+	s := NewWithOptions[int](Options{Random: seeded(1)})
+
+	for range All(s) {
+		t.Error("All() should not yield anything for an empty list")
+	}
+
+	for _, value := range []int{30, 10, 20} {
+		s.Insert(value)
+	}
+	require.Equal(t, []int{10, 20, 30}, slices.Collect(s.All()))
+
+	visited := 0
+	for value := range s.All() {
+		visited++
+		if value == 20 {
+			break
+		}
+	}
+	require.Equal(t, 2, visited)
+}
+
+func TestRange(t *testing.T) {
+	s := NewWithOptions[int](Options{Random: seeded(1)})
+	for value := 0; value < 1000; value += 3 {
+		s.Insert(value)
+	}
+
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		lo, hi := random.Intn(1100)-50, random.Intn(1100)-50
+
+		expected := []int{}
+		for value := 0; value < 1000; value += 3 {
+			if value >= lo && value <= hi {
+				expected = append(expected, value)
+			}
+		}
+
+		require.Equal(t, expected, slices.AppendSeq([]int{}, s.Range(lo, hi)), "range [%d, %d]", lo, hi)
+	}
+}
+
+func TestConcurrentModification(t *testing.T) {
+	modifications := map[string]func(s *SkipList[int]){
+		"Insert": func(s *SkipList[int]) { s.Insert(100) },
+		"Delete": func(s *SkipList[int]) { s.Delete(5) },
+	}
+
+	iterators := map[string]func(s *SkipList[int]) iter.Seq[int]{
+		"All":   All[int],
+		"Range": func(s *SkipList[int]) iter.Seq[int] { return Range(s, 3, 6) },
+	}
+
+	for name, modify := range modifications {
+		for iteratorName, iterate := range iterators {
+			t.Run(name+"/"+iteratorName, func(t *testing.T) {
+				s := NewWithOptions[int](Options{Random: seeded(1)})
+				for value := range 10 {
+					s.Insert(value)
+				}
+
+				defer func() {
+					err, _ := recover().(error)
+					require.Equal(t, true, errors.Is(err, ErrConcurrentModification))
+				}()
+
+				for range iterate(s) {
+					modify(s)
+				}
+
+				t.Error("the iterator should panic on a concurrent modification")
+			})
+		}
+	}
+
+	// inserting a value which is there or deleting one which isn't changes nothing
+	s := NewWithOptions[int](Options{Random: seeded(1)})
+	for value := range 10 {
+		s.Insert(value)
+	}
+	for value := range s.Range(3, 6) {
+		s.Insert(value)
+		s.Delete(-1)
+	}
+}
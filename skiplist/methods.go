@@ -0,0 +1,47 @@
+package skiplist
+
+import (
+	"iter"
+)
+
+// Method forms of the package-level functions
+
+func (s *SkipList[T]) Len() int {
+	return Len(s)
+}
+
+func (s *SkipList[T]) Empty() bool {
+	return Empty(s)
+}
+
+func (s *SkipList[T]) Level() int {
+	return Level(s)
+}
+
+func (s *SkipList[T]) Search(value T) bool {
+	return Search(s, value)
+}
+
+func (s *SkipList[T]) Insert(value T) bool {
+	return Insert(s, value)
+}
+
+func (s *SkipList[T]) Delete(value T) bool {
+	return Delete(s, value)
+}
+
+func (s *SkipList[T]) Min() (T, bool) {
+	return Min(s)
+}
+
+func (s *SkipList[T]) Max() (T, bool) {
+	return Max(s)
+}
+
+func (s *SkipList[T]) All() iter.Seq[T] {
+	return All(s)
+}
+
+func (s *SkipList[T]) Range(lo, hi T) iter.Seq[T] {
+	return Range(s, lo, hi)
+}
@@ -0,0 +1,221 @@
+// Package skiplist is an ordered set on a skip list: a sorted singly linked list
+// with express lanes on top. It links its own nodes the way the list package does,
+// except that a node has a next link for every level it's on. Every node gets a
+// random number of levels, each one linking it to the next node which is at least
+// as high, so a search runs along the top level and drops a level whenever the
+// next step would overshoot. That takes O(log n) expected steps with no
+// rebalancing, and an insert or delete only relinks the neighbours of one node
+// on each of its levels, which is what makes skip lists a common base for
+// concurrent ordered sets. This one isn't safe for concurrent use yet
+package skiplist
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+
+	"github.com/kirillrogovoy/computer-science/bounds"
+)
+
+var ErrConcurrentModification = bounds.ErrConcurrentModification
+
+const (
+	// DefaultP makes every fourth node go a level higher, which is Pugh's choice:
+	// fewer next pointers per node than with 1/2 for about the same search time
+	DefaultP = 0.25
+	// DefaultMaxLevel is enough for 4^16 values with DefaultP
+	DefaultMaxLevel = 16
+)
+
+type Options struct {
+	// P is the probability for a node to reach the next level. It must be in (0, 1), 0 picks DefaultP
+	P float64
+	// MaxLevel caps the number of levels of a node. 0 picks DefaultMaxLevel
+	MaxLevel int
+	// Random flips the coins which decide the levels of the nodes. Passing one with
+	// a fixed seed makes the shape of the list repeatable. nil picks a randomly seeded one
+	Random *rand.Rand
+}
+
+type node[T any] struct {
+	value T
+	// next[i] is the following node which has the level i. Level 0 links all nodes like the list package does
+	next []*node[T]
+}
+
+type SkipList[T any] struct {
+	// head is a sentinel with MaxLevel levels which comes before every node
+	head *node[T]
+	// level is the number of levels in use, the highest node has it
+	level    int
+	size     int
+	p        float64
+	maxLevel int
+	random   *rand.Rand
+	compare  func(a, b T) int
+	// mods counts structural modifications so that iterators can fail fast
+	mods int
+}
+
+// New returns a skip list of values which can be compared with <, like ints, with the default options
+func New[T cmp.Ordered]() *SkipList[T] {
+	return NewWithOptions[T](Options{})
+}
+
+func NewWithOptions[T cmp.Ordered](options Options) *SkipList[T] {
+	return NewFunc(cmp.Compare[T], options)
+}
+
+// NewFunc returns a skip list ordering values with compare, so they can be of any type
+func NewFunc[T any](compare func(a, b T) int, options Options) *SkipList[T] {
+	p := options.P
+	if p == 0 {
+		p = DefaultP
+	}
+
+	if p <= 0 || p >= 1 {
+		panic(fmt.Sprintf("P must be in (0, 1), got %v", p))
+	}
+
+	maxLevel := options.MaxLevel
+	if maxLevel == 0 {
+		maxLevel = DefaultMaxLevel
+	}
+
+	if maxLevel < 1 {
+		panic(fmt.Sprintf("MaxLevel must be at least 1, got %d", maxLevel))
+	}
+
+	random := options.Random
+	if random == nil {
+		random = rand.New(rand.NewSource(rand.Int63()))
+	}
+
+	head := &node[T]{next: make([]*node[T], maxLevel)}
+	return &SkipList[T]{head, 1, 0, p, maxLevel, random, compare, 0}
+}
+
+func Len[T any](s *SkipList[T]) int {
+	return s.size
+}
+
+func Empty[T any](s *SkipList[T]) bool {
+	return s.size == 0
+}
+
+// Level returns the number of levels in use, 1 for an empty list
+func Level[T any](s *SkipList[T]) int {
+	return s.level
+}
+
+// randomLevel flips a coin which comes up with the probability p until it doesn't,
+// so a node has the level k with the probability p^(k-1) * (1-p)
+func randomLevel[T any](s *SkipList[T]) int {
+	level := 1
+	for level < s.maxLevel && s.random.Float64() < s.p {
+		level++
+	}
+
+	return level
+}
+
+// findPrev returns the last node before value on the bottom level and fills
+// prev, if it isn't nil, with the last nodes before value on every level in use
+func findPrev[T any](s *SkipList[T], value T, prev []*node[T]) *node[T] {
+	cur := s.head
+	for level := s.level - 1; level >= 0; level-- {
+		for cur.next[level] != nil && s.compare(cur.next[level].value, value) < 0 {
+			cur = cur.next[level]
+		}
+
+		if prev != nil {
+			prev[level] = cur
+		}
+	}
+
+	return cur
+}
+
+// Search tells if value is in the list
+func Search[T any](s *SkipList[T], value T) bool {
+	next := findPrev(s, value, nil).next[0]
+	return next != nil && s.compare(next.value, value) == 0
+}
+
+// Insert adds value and tells if it's new. A value which is already there isn't added again
+func Insert[T any](s *SkipList[T], value T) bool {
+	prev := make([]*node[T], s.maxLevel)
+	next := findPrev(s, value, prev).next[0]
+	if next != nil && s.compare(next.value, value) == 0 {
+		return false
+	}
+
+	level := randomLevel(s)
+
+	// the levels which weren't in use only have the head before the new node
+	for i := s.level; i < level; i++ {
+		prev[i] = s.head
+	}
+	s.level = max(s.level, level)
+
+	newNode := &node[T]{value, make([]*node[T], level)}
+	for i := range level {
+		newNode.next[i] = prev[i].next[i]
+		prev[i].next[i] = newNode
+	}
+
+	s.size++
+	s.mods++
+	return true
+}
+
+// Delete removes value and tells if it was there
+func Delete[T any](s *SkipList[T], value T) bool {
+	prev := make([]*node[T], s.maxLevel)
+	removed := findPrev(s, value, prev).next[0]
+	if removed == nil || s.compare(removed.value, value) != 0 {
+		return false
+	}
+
+	for i := range removed.next {
+		prev[i].next[i] = removed.next[i]
+		removed.next[i] = nil
+	}
+
+	// the levels which lost their only node aren't in use anymore
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+
+	s.size--
+	s.mods++
+	return true
+}
+
+// Min returns the smallest value
+func Min[T any](s *SkipList[T]) (T, bool) {
+	first := s.head.next[0]
+	if first == nil {
+		var zero T
+		return zero, false
+	}
+
+	return first.value, true
+}
+
+// Max returns the greatest value, running along the top levels to the end
+func Max[T any](s *SkipList[T]) (T, bool) {
+	cur := s.head
+	for level := s.level - 1; level >= 0; level-- {
+		for cur.next[level] != nil {
+			cur = cur.next[level]
+		}
+	}
+
+	if cur == s.head {
+		var zero T
+		return zero, false
+	}
+
+	return cur.value, true
+}
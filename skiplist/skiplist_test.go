@@ -0,0 +1,248 @@
+package skiplist
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/kirillrogovoy/computer-science/avl"
+	"github.com/stretchr/testify/require"
+)
+
+// seeded returns a source of levels which builds the same list on every run
+func seeded(seed int64) *rand.Rand {
+	return rand.New(rand.NewSource(seed))
+}
+
+// requireSkipList checks that every level is sorted, that every level is a part
+// of the one below it and that the bottom level has all the values
+func requireSkipList[T any](t *testing.T, s *SkipList[T]) {
+	t.Helper()
+
+	require.Len(t, s.head.next, s.maxLevel)
+	for level := s.level; level < s.maxLevel; level++ {
+		require.Nil(t, s.head.next[level], "level %d isn't in use", level)
+	}
+	if s.level > 1 {
+		require.NotNil(t, s.head.next[s.level-1], "level %d is in use", s.level-1)
+	}
+
+	below := map[*node[T]]bool{}
+	for level := 0; level < s.level; level++ {
+		count := 0
+		current := map[*node[T]]bool{}
+		for cur := s.head.next[level]; cur != nil; cur = cur.next[level] {
+			require.Greater(t, len(cur.next), level)
+			require.LessOrEqual(t, len(cur.next), s.maxLevel)
+			if level > 0 {
+				require.True(t, below[cur], "a node on level %d is missing below", level)
+			}
+			if cur.next[level] != nil {
+				require.Less(t, s.compare(cur.value, cur.next[level].value), 0)
+			}
+
+			current[cur] = true
+			count++
+		}
+
+		if level == 0 {
+			require.Equal(t, s.size, count)
+		}
+		below = current
+	}
+}
+
+func TestInsertSearchDelete(t *testing.T) {
+	s := NewWithOptions[int](Options{Random: seeded(1)})
+	require.True(t, s.Empty())
+	require.False(t, s.Search(1))
+	require.False(t, s.Delete(1))
+
+	for _, value := range []int{5, 1, 9, 3, 7} {
+		require.True(t, s.Insert(value))
+	}
+	require.False(t, s.Insert(3))
+	require.Equal(t, 5, s.Len())
+	requireSkipList(t, s)
+
+	for _, value := range []int{1, 3, 5, 7, 9} {
+		require.True(t, s.Search(value))
+		require.False(t, s.Search(value+1))
+	}
+
+	require.True(t, s.Delete(5))
+	require.False(t, s.Delete(5))
+	require.False(t, s.Search(5))
+	require.Equal(t, []int{1, 3, 7, 9}, slices.Collect(s.All()))
+	requireSkipList(t, s)
+
+	for _, value := range []int{1, 3, 7, 9} {
+		require.True(t, s.Delete(value))
+	}
+	require.True(t, s.Empty())
+	require.Equal(t, 1, s.Level())
+	requireSkipList(t, s)
+}
+
+func TestMinMax(t *testing.T) {
+	s := New[string]()
+	_, ok := s.Min()
+	require.False(t, ok)
+	_, ok = s.Max()
+	require.False(t, ok)
+
+	for _, value := range []string{"m", "c", "x", "a"} {
+		s.Insert(value)
+	}
+
+	value, ok := s.Min()
+	require.True(t, ok)
+	require.Equal(t, "a", value)
+
+	value, ok = s.Max()
+	require.True(t, ok)
+	require.Equal(t, "x", value)
+}
+
+func TestRandom(t *testing.T) {
+	for _, p := range []float64{0.5, 0.25, 0.1} {
+		t.Run(fmt.Sprint(p), func(t *testing.T) {
+			random := rand.New(rand.NewSource(7))
+			s := NewWithOptions[int](Options{P: p, MaxLevel: 8, Random: seeded(7)})
+			model := map[int]bool{}
+
+			for i := 0; i < 5000; i++ {
+				value := random.Intn(500)
+				if random.Intn(3) > 0 {
+					require.Equal(t, !model[value], s.Insert(value))
+					model[value] = true
+				} else {
+					require.Equal(t, model[value], s.Delete(value))
+					delete(model, value)
+				}
+
+				if i%100 == 0 {
+					requireSkipList(t, s)
+				}
+			}
+
+			requireSkipList(t, s)
+			require.Equal(t, len(model), s.Len())
+
+			expected := []int{}
+			for value := range model {
+				expected = append(expected, value)
+			}
+			slices.Sort(expected)
+			require.Equal(t, expected, slices.Collect(s.All()))
+		})
+	}
+}
+
+func TestSeed(t *testing.T) {
+	shape := func(seed int64) []int {
+		s := NewWithOptions[int](Options{Random: seeded(seed)})
+		for value := range 100 {
+			s.Insert(value)
+		}
+
+		levels := []int{}
+		for cur := s.head.next[0]; cur != nil; cur = cur.next[0] {
+			levels = append(levels, len(cur.next))
+		}
+		return levels
+	}
+
+	// the same seed builds the same list, another one almost surely doesn't.
+	// 0 is a seed like any other
+	require.Equal(t, shape(1), shape(1))
+	require.Equal(t, shape(0), shape(0))
+	require.NotEqual(t, shape(1), shape(2))
+	require.NotEqual(t, shape(0), shape(1))
+}
+
+func TestLevels(t *testing.T) {
+	s := NewWithOptions[int](Options{P: 0.5, Random: seeded(3)})
+	for value := range 100000 {
+		s.Insert(value)
+	}
+
+	// about half of the nodes reach every next level
+	counts := make([]int, s.maxLevel+1)
+	for cur := s.head.next[0]; cur != nil; cur = cur.next[0] {
+		counts[len(cur.next)]++
+	}
+	require.Greater(t, counts[1], 45000)
+	require.Less(t, counts[1], 55000)
+	require.Greater(t, counts[2], 20000)
+	require.Less(t, counts[2], 30000)
+
+	// log2(100000) is about 17, but MaxLevel caps it
+	require.Equal(t, DefaultMaxLevel, s.Level())
+
+	capped := NewWithOptions[int](Options{MaxLevel: 1, Random: seeded(3)})
+	for value := range 100 {
+		capped.Insert(value)
+	}
+	require.Equal(t, 1, capped.Level())
+	requireSkipList(t, capped)
+}
+
+func TestOptions(t *testing.T) {
+	s := New[int]()
+	require.Equal(t, DefaultP, s.p)
+	require.Equal(t, DefaultMaxLevel, s.maxLevel)
+
+	require.Panics(t, func() { NewWithOptions[int](Options{P: 1}) })
+	require.Panics(t, func() { NewWithOptions[int](Options{P: -0.5}) })
+	require.Panics(t, func() { NewWithOptions[int](Options{MaxLevel: -1}) })
+}
+
+func TestComparator(t *testing.T) {
+	s := NewFunc(strings.Compare, Options{Random: seeded(1)})
+	s.Insert("b")
+	s.Insert("a")
+	require.True(t, s.Search("a"))
+	require.Equal(t, []string{"a", "b"}, slices.Collect(s.All()))
+
+	// case-insensitive, so "A" is the same value as "a"
+	folded := NewFunc(func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	}, Options{Random: seeded(1)})
+	require.True(t, folded.Insert("a"))
+	require.False(t, folded.Insert("A"))
+	require.True(t, folded.Search("A"))
+}
+
+// BenchmarkInsertSearch compares the skip list with a balanced tree on random values
+func BenchmarkInsertSearch(b *testing.B) {
+	values := rand.New(rand.NewSource(1)).Perm(100000)
+
+	for _, p := range []float64{0.5, 0.25} {
+		b.Run(fmt.Sprintf("skiplist/%v", p), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				s := NewWithOptions[int](Options{P: p, Random: seeded(1)})
+				for _, value := range values {
+					s.Insert(value)
+				}
+				for _, value := range values {
+					s.Search(value)
+				}
+			}
+		})
+	}
+
+	b.Run("avl", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			tree := avl.New[int, struct{}]()
+			for _, value := range values {
+				tree.Put(value, struct{}{})
+			}
+			for _, value := range values {
+				tree.Contains(value)
+			}
+		}
+	})
+}
@@ -0,0 +1,23 @@
+package stack
+
+// Method forms of the package-level functions
+
+func (s *Stack) Push(value int) {
+	Push(s, value)
+}
+
+func (s *Stack) Pop() (int, error) {
+	return Pop(s)
+}
+
+func (s *Stack) Peek() (int, error) {
+	return Peek(s)
+}
+
+func (s *Stack) Len() int {
+	return Len(s)
+}
+
+func (s *Stack) Empty() bool {
+	return Empty(s)
+}
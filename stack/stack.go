@@ -0,0 +1,108 @@
+package stack
+
+import (
+	"fmt"
+
+	"github.com/kirillrogovoy/computer-science/array"
+	"github.com/kirillrogovoy/computer-science/bounds"
+	"github.com/kirillrogovoy/computer-science/list"
+)
+
+var ErrEmpty = bounds.ErrEmpty
+
+// Backing selects the container a Stack keeps its values in
+type Backing int
+
+const (
+	// ArrayBacked pushes and pops at the end of the dynamic array, amortised O(1)
+	ArrayBacked Backing = iota
+	// ListBacked pushes and pops at the front of the linked list, O(1) with an allocation per push
+	ListBacked
+)
+
+// store is the part of a container a stack needs
+type store interface {
+	push(value int)
+	pop() (int, error)
+	peek() (int, error)
+	len() int
+}
+
+type Stack struct {
+	store store
+}
+
+func New(backing Backing) *Stack {
+	switch backing {
+	case ArrayBacked:
+		return &Stack{arrayStore{array.Create(0)}}
+	case ListBacked:
+		return &Stack{listStore{list.New()}}
+	default:
+		panic(fmt.Sprintf("Unknown stack backing %d", backing))
+	}
+}
+
+func Push(s *Stack, value int) {
+	s.store.push(value)
+}
+
+func Pop(s *Stack) (int, error) {
+	return s.store.pop()
+}
+
+func Peek(s *Stack) (int, error) {
+	return s.store.peek()
+}
+
+func Len(s *Stack) int {
+	return s.store.len()
+}
+
+func Empty(s *Stack) bool {
+	return Len(s) == 0
+}
+
+type arrayStore struct {
+	arr *array.Array
+}
+
+func (a arrayStore) push(value int) {
+	array.Push(a.arr, value)
+}
+
+func (a arrayStore) pop() (int, error) {
+	return array.TryPop(a.arr)
+}
+
+func (a arrayStore) peek() (int, error) {
+	if array.IsEmpty(a.arr) {
+		return 0, bounds.ErrEmpty
+	}
+
+	return array.TryAt(a.arr, -1)
+}
+
+func (a arrayStore) len() int {
+	return array.Size(a.arr)
+}
+
+type listStore struct {
+	l *list.List
+}
+
+func (s listStore) push(value int) {
+	list.PushFront(s.l, value)
+}
+
+func (s listStore) pop() (int, error) {
+	return list.TryPopFront(s.l)
+}
+
+func (s listStore) peek() (int, error) {
+	return list.TryFront(s.l)
+}
+
+func (s listStore) len() int {
+	return list.Size(s.l)
+}
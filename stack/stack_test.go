@@ -0,0 +1,92 @@
+package stack
+
+import (
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"testing"
+)
+
+var backings = map[string]Backing{
+	"array": ArrayBacked,
+	"list":  ListBacked,
+}
+
+func TestNewPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("New() should panic on an unknown backing")
+		}
+	}()
+
+	New(Backing(42))
+}
+
+func TestStack(t *testing.T) {
+	for name, backing := range backings {
+		t.Run(name, func(t *testing.T) {
+			s := New(backing)
+			require.Equal(t, 0, Len(s))
+			require.Equal(t, true, Empty(s))
+
+			_, err := Pop(s)
+			require.Equal(t, ErrEmpty, err)
+			_, err = Peek(s)
+			require.Equal(t, ErrEmpty, err)
+
+			for i := 1; i <= 100; i++ {
+				Push(s, i)
+				require.Equal(t, i, Len(s))
+
+				top, err := Peek(s)
+				require.Nil(t, err)
+				require.Equal(t, i, top)
+			}
+
+			// last in, first out
+			for i := 100; i >= 1; i-- {
+				value, err := Pop(s)
+				require.Nil(t, err)
+				require.Equal(t, i, value)
+				require.Equal(t, i-1, Len(s))
+			}
+
+			require.Equal(t, true, Empty(s))
+			_, err = Pop(s)
+			require.Equal(t, ErrEmpty, err)
+		})
+	}
+}
+
+func TestMethods(t *testing.T) {
+	for _, backing := range backings {
+		s := New(backing)
+		s.Push(1)
+		s.Push(2)
+
+		top, _ := s.Peek()
+		require.Equal(t, 2, top)
+		require.Equal(t, 2, s.Len())
+
+		value, _ := s.Pop()
+		require.Equal(t, 2, value)
+		require.Equal(t, false, s.Empty())
+	}
+}
+
+func BenchmarkPushPop(b *testing.B) {
+	for name, backing := range backings {
+		for _, size := range []int{10, 1000, 10000} {
+			b.Run(name+"/"+strconv.Itoa(size), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					s := New(backing)
+					for j := 0; j < size; j++ {
+						Push(s, j)
+					}
+					for j := 0; j < size; j++ {
+						Pop(s)
+					}
+				}
+			})
+		}
+	}
+}